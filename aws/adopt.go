@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// adoptExistingConflict maps an error returned by a resource Create function
+// when the remote object already exists to the import ID of that object.
+type adoptExistingConflict struct {
+	// Code and Message are matched against the Create error using isAWSErr.
+	Code    string
+	Message string
+
+	// ImportID returns the import ID of the existing remote object from the resource configuration.
+	// An empty import ID disables adoption, e.g. for generated names which cannot conflict.
+	ImportID func(d *schema.ResourceData) string
+}
+
+// adoptExistingOnCreate wraps the Create function of a resource so that, when the provider
+// adopt_existing_resources argument is enabled and Create fails with one of the given conflict errors,
+// the existing remote object is imported using the resource Importer and then reconciled
+// with the configuration using the resource Update function.
+//
+// Create errors must wrap the AWS error (%w) for conflicts to be matched.
+func adoptExistingOnCreate(r *schema.Resource, conflicts ...adoptExistingConflict) *schema.Resource {
+	create := r.Create
+
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		err := create(d, meta)
+
+		if err == nil || !meta.(*AWSClient).AdoptExistingResources {
+			return err
+		}
+
+		for _, conflict := range conflicts {
+			if !isAWSErr(err, conflict.Code, conflict.Message) {
+				continue
+			}
+
+			importID := conflict.ImportID(d)
+
+			if importID == "" {
+				return err
+			}
+
+			log.Printf("[INFO] Adopting existing resource (%s) after create error: %s", importID, err)
+
+			id, importErr := adoptExistingImportID(r, importID, meta)
+
+			if importErr != nil {
+				return fmt.Errorf("error adopting existing resource (%s): %w", importID, importErr)
+			}
+
+			d.SetId(id)
+
+			if r.Update != nil {
+				return r.Update(d, meta)
+			}
+
+			return r.Read(d, meta)
+		}
+
+		return err
+	}
+
+	return r
+}
+
+// adoptExistingImportID returns the resource ID for an import ID using the resource Importer.
+// The Importer is run against empty resource data so that it does not overwrite configured values.
+func adoptExistingImportID(r *schema.Resource, importID string, meta interface{}) (string, error) {
+	imported, err := resourceImportState(context.Background(), r, importID, meta)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(imported) != 1 {
+		return "", fmt.Errorf("expected 1 imported resource, got %d", len(imported))
+	}
+
+	return imported[0].Id(), nil
+}
+
+// resourceImportState runs the resource Importer for an import ID against empty resource data,
+// as terraform import would. Resources without an Importer are imported as-is.
+func resourceImportState(ctx context.Context, r *schema.Resource, importID string, meta interface{}) ([]*schema.ResourceData, error) {
+	data := r.Data(nil)
+	data.SetId(importID)
+
+	switch {
+	case r.Importer == nil:
+		return []*schema.ResourceData{data}, nil
+	case r.Importer.StateContext != nil:
+		return r.Importer.StateContext(ctx, data, meta)
+	case r.Importer.State != nil:
+		return r.Importer.State(data, meta)
+	default:
+		return []*schema.ResourceData{data}, nil
+	}
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+)
+
+func TestAdoptExistingOnCreate(t *testing.T) {
+	const assumeRolePolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	testCases := []struct {
+		Name          string
+		Adopt         bool
+		ExpectedError bool
+	}{
+		{
+			Name:          "disabled",
+			Adopt:         false,
+			ExpectedError: true,
+		},
+		{
+			Name:  "enabled",
+			Adopt: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			server, client := testFakeAwsClient(t)
+			client.AdoptExistingResources = testCase.Adopt
+			r := resourceAwsIamRole()
+
+			server.Lock()
+			server.IAMRoles["test"] = &fakeaws.IAMRole{
+				AssumeRolePolicyDocument: assumeRolePolicy,
+				CreateDate:               time.Now(),
+				Description:              "existing",
+				InlinePolicies:           make(map[string]string),
+				MaxSessionDuration:       3600,
+				Name:                     "test",
+				Path:                     "/",
+				RoleID:                   "AROAEXISTING",
+				Tags:                     make(map[string]string),
+			}
+			server.Unlock()
+
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"assume_role_policy": assumeRolePolicy,
+				"description":        "configured",
+				"name":               "test",
+			})
+
+			err := r.Create(d, client)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !isAWSErr(err, iam.ErrCodeEntityAlreadyExistsException, "") {
+					t.Errorf("expected %s error, got: %s", iam.ErrCodeEntityAlreadyExistsException, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("error creating: %s", err)
+			}
+
+			if got, expected := d.Id(), "test"; got != expected {
+				t.Errorf("got ID %s, expected %s", got, expected)
+			}
+
+			if got := d.Get("unique_id").(string); got != "AROAEXISTING" {
+				t.Errorf("got unique_id %s, expected existing role", got)
+			}
+
+			server.Lock()
+			description := server.IAMRoles["test"].Description
+			server.Unlock()
+
+			if description != "configured" {
+				t.Errorf("got description %q, expected existing role to be updated to %q", description, "configured")
+			}
+		})
+	}
+}
+
+func TestAdoptExistingImportID(t *testing.T) {
+	r := &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: regionalImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"region": regionSchema(),
+		},
+	}
+
+	id, err := adoptExistingImportID(r, "test@us-west-2", &AWSClient{region: "us-east-1"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if id != "test" {
+		t.Errorf("got ID %s, expected test", id)
+	}
+
+	_, err = adoptExistingImportID(r, "@us-west-2", &AWSClient{region: "us-east-1"})
+
+	if err == nil || !strings.Contains(err.Error(), "unexpected format of import ID") {
+		t.Errorf("expected import ID format error, got: %v", err)
+	}
+}
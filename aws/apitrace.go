@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
+)
+
+// resourceClientCache holds AWSClients keyed by resource type.
+// All clients in the cache share the credentials and session of the provider configuration.
+type resourceClientCache struct {
+	sync.Mutex
+	clients map[string]*AWSClient
+}
+
+// resourceClient returns an AWSClient whose API calls are traced as issued by the given resource type,
+// creating and caching it on first use. The client itself is returned if API tracing is not enabled.
+func (client *AWSClient) resourceClient(resource string) *AWSClient {
+	if client.tracer == nil || client.resourceClients == nil || client.providerConfig == nil || client.session == nil {
+		return client
+	}
+
+	client.resourceClients.Lock()
+	defer client.resourceClients.Unlock()
+
+	if resourceClient, ok := client.resourceClients.clients[resource]; ok {
+		return resourceClient
+	}
+
+	config := *client.providerConfig
+	config.SkipGetEC2Platforms = true
+
+	sess := client.session.Copy()
+	sess.Handlers.Validate.PushFrontNamed(apitrace.ResourceHandler(resource))
+
+	resourceClient := config.clientFromSession(sess, client.accountid, client.partition)
+	resourceClient.providerConfig = client.providerConfig
+	resourceClient.regionalClients = &regionalClientCache{
+		clients: map[string]*AWSClient{resourceClient.region: resourceClient},
+	}
+	resourceClient.requestCache = client.requestCache
+	resourceClient.resourceClients = client.resourceClients
+	resourceClient.supportedplatforms = client.supportedplatforms
+	resourceClient.tracer = client.tracer
+
+	client.resourceClients.clients[resource] = resourceClient
+
+	return resourceClient
+}
+
+// traceResources wraps the functions of resources, which are passed the provider meta, so that when
+// API tracing is enabled each call is recorded and API calls are traced as issued by the resource type.
+// Data source types should be prefixed with "data.".
+func traceResources(prefix string, resources map[string]*schema.Resource) {
+	traced := make(map[*schema.Resource]bool)
+
+	for name, r := range resources {
+		if r == nil || traced[r] {
+			continue
+		}
+
+		traced[r] = true
+		traceResource(prefix+name, r)
+	}
+}
+
+// traceResourceCall calls f with the resource client for the resource type, recording the call as
+// an operation of the resource with the given ID, if API tracing is enabled for the provider meta.
+// f returns whether the call succeeded.
+func traceResourceCall(meta interface{}, resource, operation string, id func() string, f func(interface{}) bool) {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || client.tracer == nil {
+		f(meta)
+		return
+	}
+
+	client.tracer.TraceResource(resource, operation, id, func() bool {
+		return f(client.resourceClient(resource))
+	})
+}
+
+func traceResource(name string, r *schema.Resource) {
+	traceCRUDFunc := func(operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			var err error
+
+			traceResourceCall(meta, name, operation, d.Id, func(meta interface{}) bool {
+				err = f(d, meta)
+				return err == nil
+			})
+
+			return err
+		}
+	}
+
+	traceCRUDContextFunc := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			traceResourceCall(meta, name, operation, d.Id, func(meta interface{}) bool {
+				diags = f(ctx, d, meta)
+				return !diags.HasError()
+			})
+
+			return diags
+		}
+	}
+
+	r.Create = traceCRUDFunc("Create", r.Create)
+	r.Read = traceCRUDFunc("Read", r.Read)
+	r.Update = traceCRUDFunc("Update", r.Update)
+	r.Delete = traceCRUDFunc("Delete", r.Delete)
+	r.CreateContext = traceCRUDContextFunc("Create", r.CreateContext)
+	r.ReadContext = traceCRUDContextFunc("Read", r.ReadContext)
+	r.UpdateContext = traceCRUDContextFunc("Update", r.UpdateContext)
+	r.DeleteContext = traceCRUDContextFunc("Delete", r.DeleteContext)
+
+	if f := r.Exists; f != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			var exists bool
+			var err error
+
+			traceResourceCall(meta, name, "Exists", d.Id, func(meta interface{}) bool {
+				exists, err = f(d, meta)
+				return err == nil
+			})
+
+			return exists, err
+		}
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			var err error
+
+			traceResourceCall(meta, name, "CustomizeDiff", diff.Id, func(meta interface{}) bool {
+				err = f(ctx, diff, meta)
+				return err == nil
+			})
+
+			return err
+		}
+	}
+
+	if r.Importer == nil {
+		return
+	}
+
+	// Importers may be shared between resources.
+	importer := *r.Importer
+	r.Importer = &importer
+
+	if f := importer.State; f != nil {
+		importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			var result []*schema.ResourceData
+			var err error
+
+			traceResourceCall(meta, name, "Import", d.Id, func(meta interface{}) bool {
+				result, err = f(d, meta)
+				return err == nil
+			})
+
+			return result, err
+		}
+	}
+
+	if f := importer.StateContext; f != nil {
+		importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			var result []*schema.ResourceData
+			var err error
+
+			traceResourceCall(meta, name, "Import", d.Id, func(meta interface{}) bool {
+				result, err = f(ctx, d, meta)
+				return err == nil
+			})
+
+			return result, err
+		}
+	}
+}
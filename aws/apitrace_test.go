@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
+)
+
+func TestProviderAPITrace_fakeaws(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	_, provider := testFakeAwsProvider(t, map[string]interface{}{
+		"api_trace_path": path,
+	})
+	client := provider.Meta().(*AWSClient)
+	r := provider.ResourcesMap["aws_ssm_parameter"]
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":  "/test",
+		"type":  ssm.ParameterTypeString,
+		"value": "hunter2",
+	})
+
+	if err := r.Create(d, client); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+
+	if got := client.resourceClient("aws_ssm_parameter"); got != client.resourceClient("aws_ssm_parameter") || got == client {
+		t.Errorf("expected a cached resource client distinct from the provider client")
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("error opening trace: %s", err)
+	}
+
+	defer f.Close()
+
+	var apiCalls, resourceCalls []*apitrace.Record
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		if strings.Contains(scanner.Text(), "hunter2") {
+			t.Errorf("trace contains sensitive value: %s", scanner.Text())
+		}
+
+		record := &apitrace.Record{}
+
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatalf("error decoding trace record (%s): %s", scanner.Text(), err)
+		}
+
+		switch record.Type {
+		case apitrace.RecordTypeAPICall:
+			apiCalls = append(apiCalls, record)
+		case apitrace.RecordTypeResource:
+			resourceCalls = append(resourceCalls, record)
+		}
+	}
+
+	if len(apiCalls) == 0 {
+		t.Fatal("expected API call records")
+	}
+
+	for _, record := range apiCalls {
+		if record.Resource != "aws_ssm_parameter" || record.Service != ssm.ServiceID || record.Region != "us-west-2" {
+			t.Errorf("unexpected API call record: %+v", record)
+		}
+
+		if record.Operation == "PutParameter" {
+			if params, ok := record.Params.(map[string]interface{}); !ok || params["Value"] != apitrace.Redacted || params["Name"] != "/test" {
+				t.Errorf("unexpected PutParameter params: %v", record.Params)
+			}
+		}
+	}
+
+	if len(resourceCalls) != 1 || resourceCalls[0].Resource != "aws_ssm_parameter" || resourceCalls[0].Operation != "Create" || resourceCalls[0].ResourceID != "/test" || resourceCalls[0].Failed {
+		t.Errorf("unexpected resource records: %v", resourceCalls)
+	}
+}
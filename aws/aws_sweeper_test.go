@@ -1,25 +1,127 @@
 package aws
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"log"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
+)
+
+// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the Terraform Plugin SDK.
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the resources Sweepers would delete without deleting them")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 4, "Maximum number of Sweepers to run concurrently in a region")
+	flagSweepReport      = flag.String("sweep-report", "", "Path of a JSON report of swept resources")
+	flagSweepTags        = flag.String("sweep-tags", "", "Comma separated list of key=value or key tags resources must have to be swept")
 )
 
 // sweeperAwsClients is a shared cache of regional AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperAwsClients map[string]interface{}
+var sweeperAwsClientsMutex sync.Mutex
+
+// testSweepers are the registered acceptance test sweepers, keyed by name.
+var testSweepers = make(map[string]*sweep.Sweeper)
 
 func TestMain(m *testing.M) {
 	sweeperAwsClients = make(map[string]interface{})
+
+	flag.Parse()
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		if err := runTestSweepers(strings.Split(regions, ",")); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
 
+// addTestSweepers registers a sweeper which deletes all resources of a type in a region.
+// Such sweepers are skipped when dry running or filtering by tags or age, see addTestListSweepers.
+func addTestSweepers(name string, s *resource.Sweeper) {
+	addTestListSweepers(name, &sweep.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F:            s.F,
+	})
+}
+
+// addTestListSweepers registers a sweeper which lists the resources of a type in a region.
+// The resources are filtered by tags and age and deleted by the sweeper framework, which supports dry runs.
+func addTestListSweepers(name string, s *sweep.Sweeper) {
+	if _, ok := testSweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	testSweepers[name] = s
+}
+
+// runTestSweepers runs the registered sweepers selected by -sweep-run in each region.
+// Dependencies between sweepers are inferred from the provider's resource schemas in addition to those declared.
+func runTestSweepers(regions []string) error {
+	tags, err := sweep.ParseTags(*flagSweepTags)
+
+	if err != nil {
+		return err
+	}
+
+	opts := sweep.Options{
+		AllowFailures: flag.Lookup("sweep-allow-failures").Value.String() == "true",
+		DryRun:        *flagSweepDryRun,
+		MinAge:        *flagSweepMinAge,
+		Parallelism:   *flagSweepParallelism,
+		Tags:          tags,
+	}
+
+	dependencies := sweep.Dependencies(testSweepers, Provider().ResourcesMap)
+	sweepers := sweep.Filter(flag.Lookup("sweep-run").Value.String(), testSweepers, dependencies)
+
+	report, err := sweep.Run(regions, sweepers, dependencies, opts)
+
+	if err != nil {
+		return err
+	}
+
+	for _, region := range report.Regions {
+		for _, s := range region.Sweepers {
+			log.Printf("[INFO] Sweeper (%s) in region (%s): %s %s%s", s.Name, region.Region, s.Status, s.Reason, s.Error)
+		}
+	}
+
+	if *flagSweepReport != "" {
+		b, err := json.MarshalIndent(report, "", "  ")
+
+		if err != nil {
+			return fmt.Errorf("error encoding sweeper report: %w", err)
+		}
+
+		if err := ioutil.WriteFile(*flagSweepReport, b, 0644); err != nil {
+			return fmt.Errorf("error writing sweeper report: %w", err)
+		}
+	}
+
+	return report.Err()
+}
+
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
 // functions for a given region
 func sharedClientForRegion(region string) (interface{}, error) {
+	sweeperAwsClientsMutex.Lock()
+	defer sweeperAwsClientsMutex.Unlock()
+
 	if client, ok := sweeperAwsClients[region]; ok {
 		return client, nil
 	}
@@ -190,15 +190,32 @@ func flattenCacheBehaviors(cbs *cloudfront.CacheBehaviors) []interface{} {
 func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.DefaultCacheBehavior {
 	dcb := &cloudfront.DefaultCacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
-		DefaultTTL:             aws.Int64(int64(m["default_ttl"].(int))),
 		FieldLevelEncryptionId: aws.String(m["field_level_encryption_id"].(string)),
-		ForwardedValues:        expandForwardedValues(m["forwarded_values"].([]interface{})[0].(map[string]interface{})),
-		MaxTTL:                 aws.Int64(int64(m["max_ttl"].(int))),
-		MinTTL:                 aws.Int64(int64(m["min_ttl"].(int))),
 		TargetOriginId:         aws.String(m["target_origin_id"].(string)),
 		ViewerProtocolPolicy:   aws.String(m["viewer_protocol_policy"].(string)),
 	}
 
+	// The legacy TTLs cannot be combined with a cache policy.
+	if v, ok := m["cache_policy_id"].(string); ok && v != "" {
+		dcb.CachePolicyId = aws.String(v)
+	} else {
+		dcb.DefaultTTL = aws.Int64(int64(m["default_ttl"].(int)))
+		dcb.MaxTTL = aws.Int64(int64(m["max_ttl"].(int)))
+		dcb.MinTTL = aws.Int64(int64(m["min_ttl"].(int)))
+	}
+
+	if v, ok := m["forwarded_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dcb.ForwardedValues = expandForwardedValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["origin_request_policy_id"].(string); ok && v != "" {
+		dcb.OriginRequestPolicyId = aws.String(v)
+	}
+
+	if v, ok := m["realtime_log_config_arn"].(string); ok && v != "" {
+		dcb.RealtimeLogConfigArn = aws.String(v)
+	}
+
 	if v, ok := m["trusted_signers"]; ok {
 		dcb.TrustedSigners = expandTrustedSigners(v.([]interface{}))
 	} else {
@@ -225,15 +242,32 @@ func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.
 func expandCacheBehavior(m map[string]interface{}) *cloudfront.CacheBehavior {
 	cb := &cloudfront.CacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
-		DefaultTTL:             aws.Int64(int64(m["default_ttl"].(int))),
 		FieldLevelEncryptionId: aws.String(m["field_level_encryption_id"].(string)),
-		ForwardedValues:        expandForwardedValues(m["forwarded_values"].([]interface{})[0].(map[string]interface{})),
-		MaxTTL:                 aws.Int64(int64(m["max_ttl"].(int))),
-		MinTTL:                 aws.Int64(int64(m["min_ttl"].(int))),
 		TargetOriginId:         aws.String(m["target_origin_id"].(string)),
 		ViewerProtocolPolicy:   aws.String(m["viewer_protocol_policy"].(string)),
 	}
 
+	// The legacy TTLs cannot be combined with a cache policy.
+	if v, ok := m["cache_policy_id"].(string); ok && v != "" {
+		cb.CachePolicyId = aws.String(v)
+	} else {
+		cb.DefaultTTL = aws.Int64(int64(m["default_ttl"].(int)))
+		cb.MaxTTL = aws.Int64(int64(m["max_ttl"].(int)))
+		cb.MinTTL = aws.Int64(int64(m["min_ttl"].(int)))
+	}
+
+	if v, ok := m["forwarded_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cb.ForwardedValues = expandForwardedValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["origin_request_policy_id"].(string); ok && v != "" {
+		cb.OriginRequestPolicyId = aws.String(v)
+	}
+
+	if v, ok := m["realtime_log_config_arn"].(string); ok && v != "" {
+		cb.RealtimeLogConfigArn = aws.String(v)
+	}
+
 	if v, ok := m["trusted_signers"]; ok {
 		cb.TrustedSigners = expandTrustedSigners(v.([]interface{}))
 	} else {
@@ -268,6 +302,15 @@ func flattenCloudFrontDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior)
 		"min_ttl":                   aws.Int64Value(dcb.MinTTL),
 	}
 
+	if dcb.CachePolicyId != nil {
+		m["cache_policy_id"] = aws.StringValue(dcb.CachePolicyId)
+	}
+	if dcb.OriginRequestPolicyId != nil {
+		m["origin_request_policy_id"] = aws.StringValue(dcb.OriginRequestPolicyId)
+	}
+	if dcb.RealtimeLogConfigArn != nil {
+		m["realtime_log_config_arn"] = aws.StringValue(dcb.RealtimeLogConfigArn)
+	}
 	if dcb.ForwardedValues != nil {
 		m["forwarded_values"] = []interface{}{flattenForwardedValues(dcb.ForwardedValues)}
 	}
@@ -305,6 +348,15 @@ func flattenCacheBehavior(cb *cloudfront.CacheBehavior) map[string]interface{} {
 	m["target_origin_id"] = aws.StringValue(cb.TargetOriginId)
 	m["min_ttl"] = int(aws.Int64Value(cb.MinTTL))
 
+	if cb.CachePolicyId != nil {
+		m["cache_policy_id"] = aws.StringValue(cb.CachePolicyId)
+	}
+	if cb.OriginRequestPolicyId != nil {
+		m["origin_request_policy_id"] = aws.StringValue(cb.OriginRequestPolicyId)
+	}
+	if cb.RealtimeLogConfigArn != nil {
+		m["realtime_log_config_arn"] = aws.StringValue(cb.RealtimeLogConfigArn)
+	}
 	if cb.ForwardedValues != nil {
 		m["forwarded_values"] = []interface{}{flattenForwardedValues(cb.ForwardedValues)}
 	}
@@ -570,6 +622,11 @@ func expandOrigin(m map[string]interface{}) *cloudfront.Origin {
 	if v, ok := m["origin_path"]; ok {
 		origin.OriginPath = aws.String(v.(string))
 	}
+	if v, ok := m["origin_shield"]; ok {
+		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
+			origin.OriginShield = expandOriginShield(s[0].(map[string]interface{}))
+		}
+	}
 	if v, ok := m["s3_origin_config"]; ok {
 		if s := v.([]interface{}); len(s) > 0 {
 			origin.S3OriginConfig = expandS3OriginConfig(s[0].(map[string]interface{}))
@@ -600,6 +657,9 @@ func flattenOrigin(or *cloudfront.Origin) map[string]interface{} {
 	if or.OriginPath != nil {
 		m["origin_path"] = aws.StringValue(or.OriginPath)
 	}
+	if or.OriginShield != nil && aws.BoolValue(or.OriginShield.Enabled) {
+		m["origin_shield"] = []interface{}{flattenOriginShield(or.OriginShield)}
+	}
 	if or.S3OriginConfig != nil && aws.StringValue(or.S3OriginConfig.OriginAccessIdentity) != "" {
 		m["s3_origin_config"] = []interface{}{flattenS3OriginConfig(or.S3OriginConfig)}
 	}
@@ -722,6 +782,11 @@ func originHash(v interface{}) int {
 	if v, ok := m["origin_path"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	if v, ok := m["origin_shield"]; ok {
+		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
+			buf.WriteString(fmt.Sprintf("%d-", originShieldHash(s[0])))
+		}
+	}
 	if v, ok := m["s3_origin_config"]; ok {
 		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
 			buf.WriteString(fmt.Sprintf("%d-", s3OriginConfigHash((s[0].(map[string]interface{})))))
@@ -880,6 +945,28 @@ func flattenCustomOriginConfigSSL(osp *cloudfront.OriginSslProtocols) *schema.Se
 	return flattenStringSet(osp.Items)
 }
 
+func expandOriginShield(m map[string]interface{}) *cloudfront.OriginShield {
+	return &cloudfront.OriginShield{
+		Enabled:            aws.Bool(m["enabled"].(bool)),
+		OriginShieldRegion: aws.String(m["origin_shield_region"].(string)),
+	}
+}
+
+func flattenOriginShield(os *cloudfront.OriginShield) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":              aws.BoolValue(os.Enabled),
+		"origin_shield_region": aws.StringValue(os.OriginShieldRegion),
+	}
+}
+
+func originShieldHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%t-", m["enabled"].(bool)))
+	buf.WriteString(fmt.Sprintf("%s-", m["origin_shield_region"].(string)))
+	return hashcode.String(buf.String())
+}
+
 func expandS3OriginConfig(m map[string]interface{}) *cloudfront.S3OriginConfig {
 	return &cloudfront.S3OriginConfig{
 		OriginAccessIdentity: aws.String(m["origin_access_identity"].(string)),
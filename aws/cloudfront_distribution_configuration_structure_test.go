@@ -123,6 +123,13 @@ func s3OriginConf() map[string]interface{} {
 	}
 }
 
+func originShieldConf() map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":              true,
+		"origin_shield_region": "us-east-1",
+	}
+}
+
 func originWithCustomConf() map[string]interface{} {
 	return map[string]interface{}{
 		"origin_id":            "CustomOrigin",
@@ -309,6 +316,41 @@ func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior(t *testing.T)
 	}
 }
 
+func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior_CachePolicy(t *testing.T) {
+	data := defaultCacheBehaviorConf()
+	data["cache_policy_id"] = "658327ea-f89d-4fab-a63d-7e88639e58f6"
+	data["origin_request_policy_id"] = "216adef6-5c7f-47e4-b989-5492eafa07d3"
+	data["realtime_log_config_arn"] = "arn:aws:cloudfront::123456789012:realtime-log-config/example"
+	data["forwarded_values"] = []interface{}{}
+	dcb := expandCloudFrontDefaultCacheBehavior(data)
+	if aws.StringValue(dcb.CachePolicyId) != "658327ea-f89d-4fab-a63d-7e88639e58f6" {
+		t.Fatalf("Expected CachePolicyId to be 658327ea-f89d-4fab-a63d-7e88639e58f6, got %v", aws.StringValue(dcb.CachePolicyId))
+	}
+	if aws.StringValue(dcb.OriginRequestPolicyId) != "216adef6-5c7f-47e4-b989-5492eafa07d3" {
+		t.Fatalf("Expected OriginRequestPolicyId to be 216adef6-5c7f-47e4-b989-5492eafa07d3, got %v", aws.StringValue(dcb.OriginRequestPolicyId))
+	}
+	if aws.StringValue(dcb.RealtimeLogConfigArn) != "arn:aws:cloudfront::123456789012:realtime-log-config/example" {
+		t.Fatalf("Expected RealtimeLogConfigArn to be set, got %v", aws.StringValue(dcb.RealtimeLogConfigArn))
+	}
+	if dcb.ForwardedValues != nil {
+		t.Fatalf("Expected ForwardedValues to be nil, got %v", dcb.ForwardedValues)
+	}
+	if dcb.DefaultTTL != nil || dcb.MaxTTL != nil || dcb.MinTTL != nil {
+		t.Fatalf("Expected TTLs to be nil, got %v, %v, %v", dcb.DefaultTTL, dcb.MaxTTL, dcb.MinTTL)
+	}
+
+	out := flattenCloudFrontDefaultCacheBehavior(dcb)
+	if out["cache_policy_id"] != "658327ea-f89d-4fab-a63d-7e88639e58f6" {
+		t.Fatalf("Expected out[cache_policy_id] to be 658327ea-f89d-4fab-a63d-7e88639e58f6, got %v", out["cache_policy_id"])
+	}
+	if out["origin_request_policy_id"] != "216adef6-5c7f-47e4-b989-5492eafa07d3" {
+		t.Fatalf("Expected out[origin_request_policy_id] to be 216adef6-5c7f-47e4-b989-5492eafa07d3, got %v", out["origin_request_policy_id"])
+	}
+	if _, ok := out["forwarded_values"]; ok {
+		t.Fatalf("Expected out[forwarded_values] to be unset, got %v", out["forwarded_values"])
+	}
+}
+
 func TestCloudFrontStructure_expandTrustedSigners(t *testing.T) {
 	data := trustedSignersConf()
 	ts := expandTrustedSigners(data)
@@ -771,6 +813,28 @@ func TestCloudFrontStructure_flattenS3OriginConfig(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_expandOrigin_OriginShield(t *testing.T) {
+	data := originWithS3Conf()
+	data["origin_shield"] = []interface{}{originShieldConf()}
+	or := expandOrigin(data)
+	if !aws.BoolValue(or.OriginShield.Enabled) {
+		t.Fatalf("Expected OriginShield.Enabled to be true, got %v", aws.BoolValue(or.OriginShield.Enabled))
+	}
+	if aws.StringValue(or.OriginShield.OriginShieldRegion) != "us-east-1" {
+		t.Fatalf("Expected OriginShield.OriginShieldRegion to be us-east-1, got %v", aws.StringValue(or.OriginShield.OriginShieldRegion))
+	}
+}
+
+func TestCloudFrontStructure_flattenOriginShield(t *testing.T) {
+	in := originShieldConf()
+	os := expandOriginShield(in)
+	out := flattenOriginShield(os)
+
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("Expected out to be %v, got %v", in, out)
+	}
+}
+
 func TestCloudFrontStructure_expandCustomErrorResponses(t *testing.T) {
 	data := customErrorResponsesConfSet()
 	ers := expandCustomErrorResponses(data)
@@ -3,12 +3,12 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -163,9 +163,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/replay"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/requestcache"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
 )
 
 type Config struct {
@@ -189,9 +193,16 @@ type Config struct {
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
-	Endpoints        map[string]string
-	IgnoreTagsConfig *keyvaluetags.IgnoreConfig
-	Insecure         bool
+	AdoptExistingResources bool
+	APITracePath           string
+	DefaultTagsConfig      *keyvaluetags.DefaultConfig
+	Endpoints              map[string]string
+	IgnoreTagsConfig       *keyvaluetags.IgnoreConfig
+	Insecure               bool
+	RateLimits             map[string]ratelimit.Limit
+	ReplayRecorder         *replay.Recorder
+	RetryRules             []*retry.Rule
+	ServiceMaxRetries      map[string]int
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -208,6 +219,7 @@ type AWSClient struct {
 	accountid                           string
 	acmconn                             *acm.ACM
 	acmpcaconn                          *acmpca.ACMPCA
+	AdoptExistingResources              bool
 	amplifyconn                         *amplify.Amplify
 	apigatewayconn                      *apigateway.APIGateway
 	apigatewayv2conn                    *apigatewayv2.ApiGatewayV2
@@ -246,6 +258,7 @@ type AWSClient struct {
 	dataexchangeconn                    *dataexchange.DataExchange
 	datapipelineconn                    *datapipeline.DataPipeline
 	datasyncconn                        *datasync.DataSync
+	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
 	daxconn                             *dax.DAX
 	devicefarmconn                      *devicefarm.DeviceFarm
 	dlmconn                             *dlm.DLM
@@ -317,6 +330,7 @@ type AWSClient struct {
 	organizationsconn                   *organizations.Organizations
 	outpostsconn                        *outposts.Outposts
 	partition                           string
+	providerConfig                      *Config
 	personalizeconn                     *personalize.Personalize
 	pinpointconn                        *pinpoint.Pinpoint
 	pricingconn                         *pricing.Pricing
@@ -327,6 +341,9 @@ type AWSClient struct {
 	rdsconn                             *rds.RDS
 	redshiftconn                        *redshift.Redshift
 	region                              string
+	regionalClients                     *regionalClientCache
+	requestCache                        *requestcache.Cache
+	resourceClients                     *resourceClientCache
 	resourcegroupsconn                  *resourcegroups.ResourceGroups
 	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 	route53domainsconn                  *route53domains.Route53Domains
@@ -343,6 +360,7 @@ type AWSClient struct {
 	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
 	servicequotasconn                   *servicequotas.ServiceQuotas
 	sesconn                             *ses.SES
+	session                             *session.Session
 	sfnconn                             *sfn.SFN
 	shieldconn                          *shield.Shield
 	signerconn                          *signer.Signer
@@ -358,6 +376,7 @@ type AWSClient struct {
 	syntheticsconn                      *synthetics.Synthetics
 	terraformVersion                    string
 	timestreamwriteconn                 *timestreamwrite.TimestreamWrite
+	tracer                              *apitrace.Tracer
 	transferconn                        *transfer.Transfer
 	wafconn                             *waf.WAF
 	wafregionalconn                     *wafregional.WAFRegional
@@ -425,11 +444,33 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	replaying := c.ReplayRecorder != nil && c.ReplayRecorder.Mode() == replay.ModeReplay
+
+	if replaying {
+		// Replayed API interactions require neither credentials nor network access.
+		awsbaseConfig.AccessKey = "replay"
+		awsbaseConfig.AssumeRoleARN = ""
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.SecretKey = "replay"
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipMetadataApiCheck = true
+		awsbaseConfig.SkipRequestingAccountId = true
+		awsbaseConfig.Token = ""
+	}
+
 	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if c.ReplayRecorder != nil {
+		c.ReplayRecorder.Configure(&sess.Handlers)
+	}
+
+	if replaying {
+		accountID = replay.AccountID
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -438,6 +479,72 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	retryRegistry := retry.NewRegistry()
+	retryRegistry.Register(defaultRetryRules...)
+	retryRegistry.Register(c.RetryRules...)
+
+	for service, maxRetries := range c.ServiceMaxRetries {
+		retryRegistry.SetMaxRetries(service, maxRetries)
+	}
+
+	// Service clients and regional sessions are copied from the base session,
+	// so the handler applies to every client.
+	sess.Handlers.Retry.PushBackNamed(retryRegistry.RetryHandler())
+
+	rateLimitRegistry := ratelimit.NewRegistry()
+
+	for service, limit := range defaultRateLimits {
+		rateLimitRegistry.SetLimit(service, limit)
+	}
+
+	for service, limit := range c.RateLimits {
+		rateLimitRegistry.SetLimit(service, limit)
+	}
+
+	// Wait for the rate limit before each request attempt, including retries,
+	// and do not send the request if the context is canceled while waiting.
+	sess.Handlers.Send.PushFrontNamed(rateLimitRegistry.SendHandler())
+	sess.Handlers.Send.AfterEachFn = request.HandlerListStopOnError
+
+	requestCache := requestcache.New()
+
+	// Mutating requests invalidate cached responses for the service before and after they are sent.
+	sess.Handlers.Send.PushFrontNamed(requestCache.InvalidateHandler())
+	sess.Handlers.Complete.PushBackNamed(requestCache.InvalidateHandler())
+
+	var tracer *apitrace.Tracer
+
+	if c.APITracePath != "" {
+		f, err := os.OpenFile(c.APITracePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("error opening API trace file (%s): %w", c.APITracePath, err)
+		}
+
+		tracer = apitrace.New(f)
+
+		// Record API calls once all attempts, including retries, are complete.
+		sess.Handlers.Complete.PushBackNamed(tracer.CompleteHandler())
+	}
+
+	client := c.clientFromSession(sess, accountID, partition)
+	client.requestCache = requestCache
+	client.regionalClients = &regionalClientCache{
+		clients: map[string]*AWSClient{c.Region: client},
+	}
+
+	if tracer != nil {
+		client.tracer = tracer
+		client.resourceClients = &resourceClientCache{
+			clients: make(map[string]*AWSClient),
+		}
+	}
+
+	return client, nil
+}
+
+// clientFromSession configures and returns an AWSClient for the Config region
+// using service clients copied from the given base session.
+func (c *Config) clientFromSession(sess *session.Session, accountID, partition string) *AWSClient {
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
@@ -448,6 +555,7 @@ func (c *Config) Client() (interface{}, error) {
 		accountid:                           accountID,
 		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
 		acmpcaconn:                          acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
+		AdoptExistingResources:              c.AdoptExistingResources,
 		amplifyconn:                         amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["amplify"])})),
 		apigatewayconn:                      apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
 		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
@@ -486,6 +594,7 @@ func (c *Config) Client() (interface{}, error) {
 		dataexchangeconn:                    dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
 		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
 		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
+		DefaultTagsConfig:                   c.DefaultTagsConfig,
 		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
 		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
 		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
@@ -555,6 +664,7 @@ func (c *Config) Client() (interface{}, error) {
 		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
 		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
 		partition:                           partition,
+		providerConfig:                      c,
 		personalizeconn:                     personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["personalize"])})),
 		pinpointconn:                        pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
 		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
@@ -578,6 +688,7 @@ func (c *Config) Client() (interface{}, error) {
 		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
 		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
 		sesconn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
+		session:                             sess,
 		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
 		signerconn:                          signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["signer"])})),
 		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
@@ -644,151 +755,6 @@ func (c *Config) Client() (interface{}, error) {
 	client.r53conn = route53.New(sess.Copy(route53Config))
 	client.shieldconn = shield.New(sess.Copy(shieldConfig))
 
-	client.apigatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.configconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.wafv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
-		}
-
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
-		}
-
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
 		if err != nil {
@@ -800,7 +766,148 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	return client, nil
+	return client
+}
+
+// defaultRateLimits are the per-region API request rate limits applied to AWS service clients
+// unless overridden in the provider configuration. They are derived from documented AWS
+// API throttling limits, leaving headroom for other clients in the same account.
+var defaultRateLimits = map[string]ratelimit.Limit{
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/throttling.html
+	// Non-mutating actions: bucket size 100, refill rate 20 per second.
+	ec2.ServiceID: {
+		RequestsPerSecond: 20,
+		Burst:             100,
+	},
+	// https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests
+	// Five requests per second per AWS account.
+	route53.ServiceID: {
+		RequestsPerSecond: 5,
+		Burst:             5,
+	},
+}
+
+// defaultRetryRules are the retry rules applied to all AWS service clients
+// before any rules from the provider configuration.
+var defaultRetryRules = []*retry.Rule{
+	// Many operations can return an error such as:
+	//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+	// Handle them all globally for the service client.
+	{
+		Service:      apigateway.ServiceID,
+		ErrorCode:    apigateway.ErrCodeConflictException,
+		ErrorMessage: "try again later",
+	},
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	{
+		Service:    applicationautoscaling.ServiceID,
+		Operations: []string{"Describe*", "List*"},
+		ErrorCode:  applicationautoscaling.ErrCodeFailedResourceAccessException,
+	},
+	{
+		Service:      appsync.ServiceID,
+		Operations:   []string{"CreateGraphqlApi"},
+		ErrorCode:    appsync.ErrCodeConcurrentModificationException,
+		ErrorMessage: "a GraphQL API creation is already in progress",
+	},
+	// When calling Config Organization Rules API actions immediately
+	// after Organization creation, the API can randomly return the
+	// OrganizationAccessDeniedException error for a few minutes, even
+	// after succeeding a few requests.
+	{
+		Service:      configservice.ServiceID,
+		Operations:   []string{"DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule"},
+		ErrorCode:    configservice.ErrCodeOrganizationAccessDeniedException,
+		ErrorMessage: "This action can be only made by AWS Organization's master account.",
+		// We only want to retry briefly as the default max retry count would
+		// excessively retry when the error could be legitimate.
+		// We currently depend on the DefaultRetryer exponential backoff here.
+		// ~10 retries gives a fair backoff of a few seconds.
+		MaxRetries: 9,
+	},
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	{
+		Service:      dynamodb.ServiceID,
+		Operations:   []string{"PutItem", "UpdateItem", "DeleteItem"},
+		ErrorCode:    dynamodb.ErrCodeLimitExceededException,
+		ErrorMessage: "Subscriber limit exceeded:",
+	},
+	{
+		Service:      ec2.ServiceID,
+		Operations:   []string{"CreateClientVpnEndpoint"},
+		ErrorCode:    "OperationNotPermitted",
+		ErrorMessage: "Endpoint cannot be created while another endpoint is being created",
+	},
+	{
+		Service:      ec2.ServiceID,
+		Operations:   []string{"CreateVpnConnection"},
+		ErrorCode:    "VpnConnectionLimitExceeded",
+		ErrorMessage: "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:      ec2.ServiceID,
+		Operations:   []string{"CreateVpnGateway"},
+		ErrorCode:    "VpnGatewayLimitExceeded",
+		ErrorMessage: "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:      ec2.ServiceID,
+		Operations:   []string{"AttachVpnGateway", "DetachVpnGateway"},
+		ErrorCode:    "InvalidParameterValue",
+		ErrorMessage: "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+	},
+	{
+		Service:      kafka.ServiceID,
+		ErrorCode:    kafka.ErrCodeTooManyRequestsException,
+		ErrorMessage: "Too Many Requests",
+	},
+	{
+		Service:      kinesis.ServiceID,
+		Operations:   []string{"CreateStream"},
+		ErrorCode:    kinesis.ErrCodeLimitExceededException,
+		ErrorMessage: "simultaneously be in CREATING or DELETING",
+	},
+	{
+		Service:      kinesis.ServiceID,
+		Operations:   []string{"CreateStream", "DeleteStream"},
+		ErrorCode:    kinesis.ErrCodeLimitExceededException,
+		ErrorMessage: "Rate exceeded for stream",
+	},
+	// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+	{
+		Service:      organizations.ServiceID,
+		ErrorCode:    organizations.ErrCodeConcurrentModificationException,
+		ErrorMessage: "Try again later",
+	},
+	// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+	{
+		Service:      storagegateway.ServiceID,
+		ErrorCode:    storagegateway.ErrCodeInvalidGatewayRequestException,
+		ErrorMessage: "The specified gateway proxy network connection is busy",
+	},
+	{
+		Service:      wafv2.ServiceID,
+		ErrorCode:    wafv2.ErrCodeWAFInternalErrorException,
+		ErrorMessage: "Retry your request",
+	},
+	{
+		Service:      wafv2.ServiceID,
+		ErrorCode:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+		ErrorMessage: "Retry",
+	},
+	// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+	{
+		Service:      wafv2.ServiceID,
+		Operations:   []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		ErrorCode:    wafv2.ErrCodeWAFTagOperationException,
+		ErrorMessage: "Retry your request",
+	},
+	{
+		Service:      wafv2.ServiceID,
+		Operations:   []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		ErrorCode:    wafv2.ErrCodeWAFTagOperationInternalErrorException,
+		ErrorMessage: "Retry your request",
+	},
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 )
 
 func dataSourceAwsArn() *schema.Resource {
@@ -37,6 +39,15 @@ func dataSourceAwsArn() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_fields": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -55,5 +66,21 @@ func dataSourceAwsArnRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("account", arn.AccountID)
 	d.Set("resource", arn.Resource)
 
+	resource, err := arns.Parse(v)
+
+	switch {
+	case errors.Is(err, arns.ErrUnknownResourceType):
+		d.Set("resource_type", "")
+		d.Set("resource_fields", nil)
+	case err != nil:
+		return fmt.Errorf("Error parsing '%s': %w", v, err)
+	default:
+		d.Set("resource_type", resource.ResourceType().Type)
+
+		if err := d.Set("resource_fields", resource.ResourceFields()); err != nil {
+			return fmt.Errorf("error setting resource_fields: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -34,6 +34,38 @@ func TestAccDataSourceAwsArn_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "region", testARN.Region),
 					resource.TestCheckResourceAttr(resourceName, "resource", testARN.Resource),
 					resource.TestCheckResourceAttr(resourceName, "service", testARN.Service),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "resource_fields.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsArn_ResourceFields(t *testing.T) {
+	resourceName := "data.aws_arn.test"
+
+	testARN := arn.ARN{
+		AccountID: "123456789012",
+		Partition: endpoints.AwsPartitionID,
+		Resource:  "role/service-role/example",
+		Service:   "iam",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsArnConfig(testARN.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceAwsArn(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource", testARN.Resource),
+					resource.TestCheckResourceAttr(resourceName, "service", testARN.Service),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "role"),
+					resource.TestCheckResourceAttr(resourceName, "resource_fields.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_fields.path", "/service-role/"),
+					resource.TestCheckResourceAttr(resourceName, "resource_fields.role_name", "example"),
 				),
 			},
 		},
@@ -0,0 +1,155 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsCloudFrontCachePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontCachePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"max_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"parameters_in_cache_key_and_forwarded_to_origin": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookies_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cookie_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"cookies": cloudFrontPolicyItemsSchemaComputed(),
+								},
+							},
+						},
+						"enable_accept_encoding_brotli": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_accept_encoding_gzip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"headers_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"headers": cloudFrontPolicyItemsSchemaComputed(),
+								},
+							},
+						},
+						"query_strings_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query_string_behavior": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"query_strings": cloudFrontPolicyItemsSchemaComputed(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+
+	// Looking up by name also finds the AWS managed policies, e.g. "Managed-CachingOptimized".
+	if name := d.Get("name").(string); name != "" {
+		cachePolicy, err := finder.CachePolicyByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("no CloudFront Cache Policy found with name: %s", name)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", name, err)
+		}
+
+		id = aws.StringValue(cachePolicy.Id)
+	}
+
+	output, err := finder.CachePolicyByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontCachePolicyConfig(d, output.CachePolicy.CachePolicyConfig)
+}
+
+// cloudFrontPolicyItemsSchemaComputed is the data source counterpart of cloudFrontPolicyItemsSchema.
+func cloudFrontPolicyItemsSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"items": {
+					Type:     schema.TypeSet,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceCloudFrontCachePolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cloudfront_cache_policy.test"
+	resourceName := "aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontCachePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_ttl", resourceName, "default_ttl"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_ttl", resourceName, "max_ttl"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "min_ttl", resourceName, "min_ttl"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parameters_in_cache_key_and_forwarded_to_origin.#", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceCloudFrontCachePolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfigManaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CachingOptimized"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters_in_cache_key_and_forwarded_to_origin.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontCachePolicyDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontCachePolicyConfig(rName),
+		`
+data "aws_cloudfront_cache_policy" "test" {
+  id = aws_cloudfront_cache_policy.test.id
+}
+`)
+}
+
+const testAccAWSCloudFrontCachePolicyDataSourceConfigManaged = `
+data "aws_cloudfront_cache_policy" "test" {
+  name = "Managed-CachingOptimized"
+}
+`
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontOriginRequestPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cookies_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookie_behavior": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cookies": cloudFrontPolicyItemsSchemaComputed(),
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"headers_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header_behavior": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"headers": cloudFrontPolicyItemsSchemaComputed(),
+					},
+				},
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"query_strings_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_string_behavior": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query_strings": cloudFrontPolicyItemsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+
+	// Looking up by name also finds the AWS managed policies, e.g. "Managed-CORS-S3Origin".
+	if name := d.Get("name").(string); name != "" {
+		originRequestPolicy, err := finder.OriginRequestPolicyByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("no CloudFront Origin Request Policy found with name: %s", name)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): %w", name, err)
+		}
+
+		id = aws.StringValue(originRequestPolicy.Id)
+	}
+
+	output, err := finder.OriginRequestPolicyByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontOriginRequestPolicyConfig(d, output.OriginRequestPolicy.OriginRequestPolicyConfig)
+}
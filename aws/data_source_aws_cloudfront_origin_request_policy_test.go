@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceCloudFrontOriginRequestPolicy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cloudfront_origin_request_policy.test"
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cookies_config.#", resourceName, "cookies_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "headers_config.#", resourceName, "headers_config.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_strings_config.#", resourceName, "query_strings_config.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceCloudFrontOriginRequestPolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPartitionHasServicePreCheck(cloudfront.EndpointsID, t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CORS-S3Origin"),
+					resource.TestCheckResourceAttr(dataSourceName, "headers_config.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontOriginRequestPolicyConfig(rName),
+		`
+data "aws_cloudfront_origin_request_policy" "test" {
+  id = aws_cloudfront_origin_request_policy.test.id
+}
+`)
+}
+
+const testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged = `
+data "aws_cloudfront_origin_request_policy" "test" {
+  name = "Managed-CORS-S3Origin"
+}
+`
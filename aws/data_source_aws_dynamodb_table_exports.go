@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsDynamoDbTableExports() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDynamoDbTableExportsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"export_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDynamoDbTableExportsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.ListExportsInput{}
+
+	if v, ok := d.GetOk("table_arn"); ok {
+		input.TableArn = aws.String(v.(string))
+	}
+
+	var exports []interface{}
+
+	err := conn.ListExportsPages(input, func(page *dynamodb.ListExportsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.ExportSummaries {
+			if summary == nil {
+				continue
+			}
+
+			exports = append(exports, map[string]interface{}{
+				"arn":           aws.StringValue(summary.ExportArn),
+				"export_status": aws.StringValue(summary.ExportStatus),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing DynamoDB Table Exports: %w", err)
+	}
+
+	if v, ok := d.GetOk("table_arn"); ok {
+		d.SetId(v.(string))
+	} else {
+		d.SetId(meta.(*AWSClient).region)
+	}
+
+	if err := d.Set("exports", exports); err != nil {
+		return fmt.Errorf("error setting exports: %w", err)
+	}
+
+	return nil
+}
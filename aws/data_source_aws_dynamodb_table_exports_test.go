@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceDynamoDbTableExports_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_dynamodb_table_exports.test"
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbTableExportsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "exports.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "exports.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "exports.0.export_status", dynamodb.ExportStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDynamoDbTableExportsDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSDynamoDbTableExportConfig(rName),
+		`
+data "aws_dynamodb_table_exports" "test" {
+  table_arn = aws_dynamodb_table_export.test.table_arn
+}
+`)
+}
@@ -15,11 +15,20 @@ import (
 var dataSourceAwsIamPolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
 func dataSourceAwsIamPolicyDocument() *schema.Resource {
-	setOfString := &schema.Schema{
+	setOfAction := &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validateIAMPolicyAction,
+		},
+	}
+	setOfResource := &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateIAMPolicyResource,
 		},
 	}
 
@@ -31,6 +40,13 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"override_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"policy_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -39,6 +55,13 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_policy_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"statement": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -54,10 +77,10 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 							Default:      "Allow",
 							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
 						},
-						"actions":        setOfString,
-						"not_actions":    setOfString,
-						"resources":      setOfString,
-						"not_resources":  setOfString,
+						"actions":        setOfAction,
+						"not_actions":    setOfAction,
+						"resources":      setOfResource,
+						"not_resources":  setOfResource,
 						"principals":     dataSourceAwsIamPolicyPrincipalSchema(),
 						"not_principals": dataSourceAwsIamPolicyPrincipalSchema(),
 						"condition": {
@@ -66,12 +89,14 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"test": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIAMPolicyConditionOperator,
 									},
 									"variable": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIAMPolicyConditionKey,
 									},
 									"values": {
 										Type:     schema.TypeSet,
@@ -99,6 +124,13 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"statement_origins": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -111,6 +143,25 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		if err := json.Unmarshal([]byte(sourceJSON.(string)), mergedDoc); err != nil {
 			return err
 		}
+
+		mergedDoc.setStatementOrigins("source_json")
+	}
+
+	// merge in source_policy_documents in order, which must not share Sids with each other or source_json
+	for i, sourceJSON := range d.Get("source_policy_documents").([]interface{}) {
+		origin := fmt.Sprintf("source_policy_documents.%d", i)
+		sourceDoc, err := dataSourceAwsIamPolicyDocumentDecode(sourceJSON, origin)
+		if err != nil {
+			return err
+		}
+
+		if sourceDoc == nil {
+			continue
+		}
+
+		if err := mergedDoc.MergeUniqueSids(sourceDoc); err != nil {
+			return fmt.Errorf("error merging %s: %w. Either remove the Sid or ensure the Sid is unique across source_json and source_policy_documents.", origin, err)
+		}
 	}
 
 	// process the current document
@@ -131,6 +182,7 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 			cfgStmt := stmtI.(map[string]interface{})
 			stmt := &IAMPolicyStatement{
 				Effect: cfgStmt["effect"].(string),
+				origin: fmt.Sprintf("statement.%d", i),
 			}
 
 			if sid, ok := cfgStmt["sid"]; ok {
@@ -210,6 +262,21 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 			return err
 		}
 
+		overrideDoc.setStatementOrigins("override_json")
+		mergedDoc.Merge(overrideDoc)
+	}
+
+	// merge in override_policy_documents in order, each overriding statements with the same Sid
+	for i, overrideJSON := range d.Get("override_policy_documents").([]interface{}) {
+		overrideDoc, err := dataSourceAwsIamPolicyDocumentDecode(overrideJSON, fmt.Sprintf("override_policy_documents.%d", i))
+		if err != nil {
+			return err
+		}
+
+		if overrideDoc == nil {
+			continue
+		}
+
 		mergedDoc.Merge(overrideDoc)
 	}
 
@@ -223,9 +290,37 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
 
+	if err := d.Set("statement_origins", mergedDoc.statementOrigins()); err != nil {
+		return fmt.Errorf("error setting statement_origins: %w", err)
+	}
+
 	return nil
 }
 
+// dataSourceAwsIamPolicyDocumentDecode decodes one of the source_policy_documents or override_policy_documents.
+// Empty documents are skipped, allowing documents to be included conditionally.
+func dataSourceAwsIamPolicyDocumentDecode(v interface{}, origin string) (*IAMPolicyDoc, error) {
+	policy, ok := v.(string)
+
+	if !ok || policy == "" {
+		return nil, nil
+	}
+
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", origin, err)
+	}
+
+	if sid := doc.DuplicateSid(); sid != "" {
+		return nil, fmt.Errorf("found duplicate Sid (%s) in %s. Either remove the Sid or ensure the Sid is unique across all statements of the document.", sid, origin)
+	}
+
+	doc.setStatementOrigins(origin)
+
+	return doc, nil
+}
+
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
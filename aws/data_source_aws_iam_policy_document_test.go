@@ -146,6 +146,51 @@ func TestAccAWSDataSourceIAMPolicyDocument_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_sourcePolicyDocuments(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccAWSIAMPolicyDocumentSourcePolicyDocumentsExpectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.0", "source_policy_documents.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.1", "statement.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.2", "statement.1"),
+				),
+			},
+			{
+				Config:      testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConflictingConfig,
+				ExpectError: regexp.MustCompile(`error merging source_policy_documents.1: duplicate Sid \(Baseline\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_overridePolicyDocuments(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverridePolicyDocumentsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json", testAccAWSIAMPolicyDocumentOverridePolicyDocumentsExpectedJSON),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.0", "override_policy_documents.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statement_origins.1", "override_policy_documents.0"),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/10777
 func TestAccAWSDataSourceIAMPolicyDocument_Statement_Principal_Identifiers_StringAndSlice(t *testing.T) {
 	dataSourceName := "data.aws_iam_policy_document.test"
@@ -1022,3 +1067,127 @@ func testAccAWSIAMPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultipl
   ]
 }`, testAccGetPartition())
 }
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConfig = `
+data "aws_iam_policy_document" "baseline" {
+  statement {
+    sid       = "Baseline"
+    actions   = ["ec2:DescribeAccountAttributes"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "Team"
+    actions   = ["s3:*"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [
+    data.aws_iam_policy_document.baseline.json,
+    "",
+  ]
+
+  statement {
+    sid       = "Team"
+    actions   = ["sqs:*"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["sns:Publish"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Baseline",
+      "Effect": "Allow",
+      "Action": "ec2:DescribeAccountAttributes",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Team",
+      "Effect": "Allow",
+      "Action": "sqs:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "sns:Publish",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourcePolicyDocumentsConflictingConfig = `
+data "aws_iam_policy_document" "baseline" {
+  statement {
+    sid       = "Baseline"
+    actions   = ["ec2:DescribeAccountAttributes"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [
+    data.aws_iam_policy_document.baseline.json,
+    data.aws_iam_policy_document.baseline.json,
+  ]
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverridePolicyDocumentsConfig = `
+data "aws_iam_policy_document" "exception_1" {
+  statement {
+    sid       = "Exception"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "exception_2" {
+  statement {
+    sid       = "Base"
+    actions   = ["sqs:SendMessage"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  override_policy_documents = [
+    data.aws_iam_policy_document.exception_1.json,
+    data.aws_iam_policy_document.exception_2.json,
+  ]
+
+  statement {
+    sid       = "Base"
+    actions   = ["sqs:*"]
+    resources = ["*"]
+  }
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverridePolicyDocumentsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Base",
+      "Effect": "Allow",
+      "Action": "sqs:SendMessage",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Exception",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    }
+  ]
+}`
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsIamPolicyEvaluation() *schema.Resource {
+	listOfPolicy := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceAwsIamPolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIAMPolicyAction,
+			},
+			"allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateIAMPolicyConditionKey,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deciding_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deciding_statement_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_policies": listOfPolicy,
+			"permissions_boundary": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "*",
+			},
+			"resource_policies":        listOfPolicy,
+			"service_control_policies": listOfPolicy,
+		},
+	}
+}
+
+func dataSourceAwsIamPolicyEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	req := &iamPolicyEvaluationRequest{
+		Principal: d.Get("principal").(string),
+		Action:    d.Get("action").(string),
+		Resource:  d.Get("resource").(string),
+		Context:   make(map[string][]string),
+	}
+
+	for _, tfMapRaw := range d.Get("context").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := strings.ToLower(tfMap["key"].(string))
+
+		for _, v := range tfMap["values"].([]interface{}) {
+			value, _ := v.(string)
+			req.Context[key] = append(req.Context[key], value)
+		}
+	}
+
+	policies := &iamPolicyEvaluationPolicies{}
+
+	for _, v := range []struct {
+		key  string
+		docs *[]*IAMPolicyDoc
+	}{
+		{"identity_policies", &policies.IdentityPolicies},
+		{"resource_policies", &policies.ResourcePolicies},
+		{"service_control_policies", &policies.ServiceControlPolicies},
+	} {
+		for i, policy := range d.Get(v.key).([]interface{}) {
+			doc, err := dataSourceAwsIamPolicyDocumentDecode(policy, fmt.Sprintf("%s.%d", v.key, i))
+
+			if err != nil {
+				return err
+			}
+
+			if doc == nil {
+				continue
+			}
+
+			*v.docs = append(*v.docs, doc)
+		}
+	}
+
+	doc, err := dataSourceAwsIamPolicyDocumentDecode(d.Get("permissions_boundary"), "permissions_boundary")
+
+	if err != nil {
+		return err
+	}
+
+	policies.PermissionsBoundary = doc
+
+	result := evaluateIAMPolicies(req, policies)
+
+	var sid string
+
+	if result.Statement != nil {
+		sid = result.Statement.Sid
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join([]string{req.Principal, req.Action, req.Resource, result.Decision, result.Origin, sid}, ","))))
+	d.Set("allowed", result.Decision == iam.PolicyEvaluationDecisionTypeAllowed)
+	d.Set("decision", result.Decision)
+	d.Set("deciding_policy", result.Origin)
+	d.Set("deciding_statement_sid", sid)
+
+	return nil
+}
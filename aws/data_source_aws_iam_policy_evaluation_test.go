@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDataSourceIAMPolicyEvaluation_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfig("s3:GetObject", "arn:aws:s3:::example/home/alice/notes.txt", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_policy", "identity_policies.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement_sid", "ReadHome"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfig("s3:GetObject", "arn:aws:s3:::example/home/alice/notes.txt", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_policy", "identity_policies.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement_sid", "DenyInsecureTransport"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfig("s3:GetObject", "arn:aws:s3:::example/home/bob/notes.txt", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_policy", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement_sid", ""),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfig("s3:DeleteObject", "arn:aws:s3:::example/home/alice/notes.txt", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_policy", "permissions_boundary"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyEvaluation_resourcePolicies(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfigResourcePolicies("arn:aws:iam::123456789012:role/publisher"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_policy", "resource_policies.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "deciding_statement_sid", "AllowPublisher"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyEvaluationConfigResourcePolicies("arn:aws:iam::123456789012:role/other"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSIAMPolicyEvaluationConfig(action, resource, secureTransport string) string {
+	return composeConfig(testAccAWSIAMPolicyEvaluationConfigPolicies, `
+data "aws_iam_policy_evaluation" "test" {
+  principal = "arn:aws:iam::123456789012:user/alice"
+  action    = "`+action+`"
+  resource  = "`+resource+`"
+
+  identity_policies = [
+    data.aws_iam_policy_document.read_home.json,
+    data.aws_iam_policy_document.deny_insecure_transport.json,
+  ]
+
+  permissions_boundary = data.aws_iam_policy_document.boundary.json
+
+  context {
+    key    = "aws:username"
+    values = ["alice"]
+  }
+
+  context {
+    key    = "aws:SecureTransport"
+    values = ["`+secureTransport+`"]
+  }
+}
+`)
+}
+
+const testAccAWSIAMPolicyEvaluationConfigPolicies = `
+data "aws_iam_policy_document" "read_home" {
+  statement {
+    sid       = "ReadHome"
+    actions   = ["s3:GetObject", "s3:DeleteObject"]
+    resources = ["arn:aws:s3:::example/home/&{aws:username}/*"]
+  }
+}
+
+data "aws_iam_policy_document" "deny_insecure_transport" {
+  statement {
+    sid       = "DenyInsecureTransport"
+    effect    = "Deny"
+    actions   = ["s3:*"]
+    resources = ["*"]
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "boundary" {
+  statement {
+    actions   = ["s3:Get*", "s3:List*"]
+    resources = ["*"]
+  }
+}
+`
+
+func testAccAWSIAMPolicyEvaluationConfigResourcePolicies(principal string) string {
+	return `
+data "aws_iam_policy_document" "topic" {
+  statement {
+    sid       = "AllowPublisher"
+    actions   = ["sns:Publish"]
+    resources = ["arn:aws:sns:us-west-2:123456789012:alerts"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:aws:iam::123456789012:role/publisher"]
+    }
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  principal         = "` + principal + `"
+  action            = "sns:Publish"
+  resource          = "arn:aws:sns:us-west-2:123456789012:alerts"
+  resource_policies = [data.aws_iam_policy_document.topic.json]
+}
+`
+}
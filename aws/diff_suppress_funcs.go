@@ -120,3 +120,16 @@ func suppressEquivalentJsonOrYamlDiffs(k, old, new string, d *schema.ResourceDat
 func suppressEqualCIDRBlockDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return cidrBlocksEqual(old, new)
 }
+
+// suppressCloudFrontCacheBehaviorTTLWithCachePolicy suppresses differences in
+// the legacy TTL arguments of a CloudFront cache behavior that uses a cache
+// policy. The TTLs are then taken from the cache policy and not sent to the API.
+func suppressCloudFrontCacheBehaviorTTLWithCachePolicy(k, old, new string, d *schema.ResourceData) bool {
+	i := strings.LastIndex(k, ".")
+
+	if i == -1 {
+		return false
+	}
+
+	return d.Get(k[:i+1]+"cache_policy_id").(string) != ""
+}
@@ -264,3 +264,26 @@ Outputs:
 		}
 	}
 }
+
+func TestSuppressCloudFrontCacheBehaviorTTLWithCachePolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAwsCloudFrontDistribution().Schema, map[string]interface{}{
+		"default_cache_behavior": []interface{}{
+			map[string]interface{}{
+				"cache_policy_id": "658327ea-f89d-4fab-a63d-7e88639e58f6",
+			},
+		},
+		"ordered_cache_behavior": []interface{}{
+			map[string]interface{}{
+				"path_pattern": "/content/*",
+			},
+		},
+	})
+
+	if !suppressCloudFrontCacheBehaviorTTLWithCachePolicy("default_cache_behavior.0.default_ttl", "0", "86400", d) {
+		t.Errorf("Expected TTL difference to be suppressed for cache behavior with cache policy")
+	}
+
+	if suppressCloudFrontCacheBehaviorTTLWithCachePolicy("ordered_cache_behavior.0.default_ttl", "0", "86400", d) {
+		t.Errorf("Expected TTL difference not to be suppressed for cache behavior without cache policy")
+	}
+}
@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/discovery"
+)
+
+// DiscoveredResource is an existing resource found by DiscoverResources.
+type DiscoveredResource struct {
+	// Type is the Terraform resource type, e.g. aws_sqs_queue.
+	Type string
+
+	// Name is the Terraform resource name, unique within the resource type.
+	Name string
+
+	// ImportID is the identifier accepted by terraform import.
+	ImportID string
+
+	// Config is a Terraform configuration resource block populated from the resource Read function.
+	Config string
+
+	// ImportCommand is the terraform import shell command for the resource.
+	ImportCommand string
+}
+
+// resourceListers maps resource types to functions returning the import IDs
+// of all resources of that type in the client region. The same functions
+// are used by the acceptance test sweepers.
+var resourceListers = map[string]func(client *AWSClient) ([]string, error){
+	"aws_cloudwatch_log_group": func(client *AWSClient) ([]string, error) {
+		return listCloudWatchLogGroupNames(client.cloudwatchlogsconn)
+	},
+	"aws_iam_role": func(client *AWSClient) ([]string, error) {
+		return listIamRoleNames(client.iamconn)
+	},
+	"aws_sns_topic": func(client *AWSClient) ([]string, error) {
+		return listSnsTopicArns(client.snsconn)
+	},
+	"aws_sqs_queue": func(client *AWSClient) ([]string, error) {
+		return listSqsQueueUrls(client.sqsconn)
+	},
+}
+
+// DiscoverableResourceTypes returns the resource types supported by DiscoverResources.
+func DiscoverableResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(resourceListers))
+
+	for resourceType := range resourceListers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	sort.Strings(resourceTypes)
+
+	return resourceTypes
+}
+
+// DiscoverResources lists all resources of the given type in the region of the configured provider
+// and returns their import IDs along with configuration populated from the resource Read function.
+// Provider ignore_tags configuration is respected, as it is by Read.
+func DiscoverResources(ctx context.Context, p *schema.Provider, resourceType string) ([]*DiscoveredResource, error) {
+	client, ok := p.Meta().(*AWSClient)
+
+	if !ok {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+
+	r, ok := p.ResourcesMap[resourceType]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type: %s", resourceType)
+	}
+
+	return discoverResources(ctx, resourceType, r, client)
+}
+
+func discoverResources(ctx context.Context, resourceType string, r *schema.Resource, client *AWSClient) ([]*DiscoveredResource, error) {
+	lister, ok := resourceListers[resourceType]
+
+	if !ok {
+		return nil, fmt.Errorf("resource type (%s) does not support discovery", resourceType)
+	}
+
+	importIDs, err := lister(client)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s resources: %w", resourceType, err)
+	}
+
+	names := make(discovery.UniqueResourceNames)
+	var discovered []*DiscoveredResource
+
+	for _, importID := range importIDs {
+		imported, err := resourceImportState(ctx, r, importID, client)
+
+		if err != nil {
+			return nil, fmt.Errorf("error importing %s (%s): %w", resourceType, importID, err)
+		}
+
+		for _, data := range imported {
+			state, diags := r.RefreshWithoutUpgrade(ctx, data.State(), client)
+
+			if diags.HasError() {
+				return nil, fmt.Errorf("error reading %s (%s): %v", resourceType, importID, diags)
+			}
+
+			if state == nil || state.ID == "" {
+				log.Printf("[WARN] %s (%s) not found, skipping", resourceType, importID)
+				continue
+			}
+
+			name := names.Name(discovery.NameFromID(importID))
+
+			discovered = append(discovered, &DiscoveredResource{
+				Type:          resourceType,
+				Name:          name,
+				ImportID:      importID,
+				Config:        discovery.ResourceBlock(resourceType, name, r.Schema, r.Data(state)),
+				ImportCommand: discovery.ImportCommand(resourceType, name, importID),
+			})
+		}
+	}
+
+	return discovered, nil
+}
+
+func listCloudWatchLogGroupNames(conn *cloudwatchlogs.CloudWatchLogs) ([]string, error) {
+	var names []string
+
+	err := conn.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{}, func(page *cloudwatchlogs.DescribeLogGroupsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, logGroup := range page.LogGroups {
+			if logGroup == nil {
+				continue
+			}
+
+			names = append(names, aws.StringValue(logGroup.LogGroupName))
+		}
+
+		return !isLast
+	})
+
+	return names, err
+}
+
+func listIamRoleNames(conn *iam.IAM) ([]string, error) {
+	var names []string
+
+	err := conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, role := range page.Roles {
+			if role == nil {
+				continue
+			}
+
+			names = append(names, aws.StringValue(role.RoleName))
+		}
+
+		return !isLast
+	})
+
+	return names, err
+}
+
+func listSnsTopicArns(conn *sns.SNS) ([]string, error) {
+	var arns []string
+
+	err := conn.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, topic := range page.Topics {
+			if topic == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(topic.TopicArn))
+		}
+
+		return !isLast
+	})
+
+	return arns, err
+}
+
+func listSqsQueueUrls(conn *sqs.SQS) ([]string, error) {
+	output, err := conn.ListQueues(&sqs.ListQueuesInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aws.StringValueSlice(output.QueueUrls), nil
+}
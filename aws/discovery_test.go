@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestDiscoverableResourceTypes(t *testing.T) {
+	p := Provider()
+
+	for _, resourceType := range DiscoverableResourceTypes() {
+		r, ok := p.ResourcesMap[resourceType]
+
+		if !ok {
+			t.Errorf("discoverable resource type %s not found in provider", resourceType)
+			continue
+		}
+
+		if r.Importer == nil {
+			t.Errorf("discoverable resource type %s does not support import", resourceType)
+		}
+	}
+}
+
+func TestDiscoverResources_fakeaws(t *testing.T) {
+	_, client := testFakeAwsClient(t)
+	client.IgnoreTagsConfig = &keyvaluetags.IgnoreConfig{
+		Keys: keyvaluetags.New([]interface{}{"Ignored"}),
+	}
+	r := resourceAwsSqsQueue()
+
+	for _, name := range []string{"test-1", "test.1"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"delay_seconds": 10,
+			"name":          name,
+			"tags": map[string]interface{}{
+				"Ignored": "value",
+				"Name":    name,
+			},
+		})
+
+		if err := resourceAwsSqsQueueCreate(d, client); err != nil {
+			t.Fatalf("error creating: %s", err)
+		}
+	}
+
+	discovered, err := discoverResources(context.Background(), "aws_sqs_queue", r, client)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(discovered), 2; got != expected {
+		t.Fatalf("got %d resources, expected %d", got, expected)
+	}
+
+	for i, expectedName := range []string{"test_1", "test_1_2"} {
+		resource := discovered[i]
+
+		if resource.Name != expectedName {
+			t.Errorf("got name %s, expected %s", resource.Name, expectedName)
+		}
+
+		if !strings.HasPrefix(resource.Config, `resource "aws_sqs_queue" "`+expectedName+`" {`) {
+			t.Errorf("unexpected config:\n%s", resource.Config)
+		}
+
+		for _, expected := range []string{"delay_seconds = 10\n", `"Name" = `} {
+			if !strings.Contains(resource.Config, expected) {
+				t.Errorf("expected config to contain %q, got:\n%s", expected, resource.Config)
+			}
+		}
+
+		for _, unexpected := range []string{"Ignored", "visibility_timeout_seconds", "arn ="} {
+			if strings.Contains(resource.Config, unexpected) {
+				t.Errorf("expected config not to contain %q, got:\n%s", unexpected, resource.Config)
+			}
+		}
+
+		if expected := "terraform import 'aws_sqs_queue." + expectedName + "' '" + resource.ImportID + "'\n"; resource.ImportCommand != expected {
+			t.Errorf("got import command %s, expected %s", resource.ImportCommand, expected)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
+)
+
+// validateAgeRecipient validates an age X25519 recipient ("age1...").
+var validateAgeRecipient = validation.StringMatch(regexp.MustCompile(`^age1[02-9ac-hj-np-z]{58}$`), "must be an age X25519 recipient (age1...)")
+
+// secretEncrypter returns the Encrypter selected by a resource's pgp_key, kms_key_id or
+// age_recipient argument, or nil if the resource's secrets are stored in state as plaintext.
+func secretEncrypter(d *schema.ResourceData, meta interface{}) (encryption.Encrypter, error) {
+	if v, ok := d.GetOk("pgp_key"); ok {
+		return encryption.NewPGPEncrypter(strings.TrimSpace(v.(string)))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		return encryption.NewKMSEncrypter(meta.(*AWSClient).kmsconn, v.(string)), nil
+	}
+
+	if v, ok := d.GetOk("age_recipient"); ok {
+		return encryption.NewAgeEncrypter(v.(string))
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
+)
+
+// Test vector from the age specification test kit.
+const (
+	testAgeIdentity1  = "AGE-SECRET-KEY-1GFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPQ4EGAEX"
+	testAgeRecipient1 = "age1zvkyg2lqzraa2lnjvqej32nkuu0ues2s82hzrye869xeexvn73equnujwj"
+)
+
+func TestValidateAgeRecipient(t *testing.T) {
+	validNames := []string{
+		testAgeRecipient1,
+		"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p",
+	}
+	for _, v := range validNames {
+		_, errors := validateAgeRecipient(v, "age_recipient")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid age recipient: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"age1",
+		testAgeIdentity1,
+		"AGE1ZVKYG2LQZRAA2LNJVQEJ32NKUU0UES2S82HZRYE869XEEXVN73EQUNUJWJ",
+		"age1zvkyg2lqzraa2lnjvqej32nkuu0ues2s82hzrye869xeexvn73equnujwjb",
+		"age1zvkyg2lqzraa2lnjvqej32nkuu0ues2s82hzrye869xeexvn73equnujwi",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAgeRecipient(v, "age_recipient")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid age recipient", v)
+		}
+	}
+}
+
+// testAccCheckResourceAttrDecrypted decrypts a resource's encrypted attribute and checks the decrypted value.
+func testAccCheckResourceAttrDecrypted(name, key string, decrypt func(string) (string, error), check func(string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		encrypted, ok := rs.Primary.Attributes[key]
+		if !ok || encrypted == "" {
+			return fmt.Errorf("%s: Attribute '%s' not found", name, key)
+		}
+
+		value, err := decrypt(encrypted)
+		if err != nil {
+			return fmt.Errorf("%s: error decrypting attribute '%s': %w", name, key, err)
+		}
+
+		return check(value)
+	}
+}
+
+func testAccAgeDecrypt(identity string) func(string) (string, error) {
+	return func(encrypted string) (string, error) {
+		return encryption.AgeDecrypt(identity, encrypted)
+	}
+}
+
+func testAccKmsEnvelopeDecrypt(encrypted string) (string, error) {
+	return encryption.KMSEnvelopeDecrypt(testAccProvider.Meta().(*AWSClient).kmsconn, encrypted)
+}
+
+func testAccCheckDecryptedLength(length int) func(string) error {
+	return func(value string) error {
+		if len(value) != length {
+			return fmt.Errorf("expected decrypted value of length %d, got %d", length, len(value))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,524 @@
+package aws
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+// iamPolicyEvaluationRequest is the request context evaluated against IAM policies.
+type iamPolicyEvaluationRequest struct {
+	Principal string
+	Action    string
+	Resource  string
+
+	// Context maps lower case condition keys to their values.
+	Context map[string][]string
+}
+
+// iamPolicyEvaluationPolicies are the policies which apply to a request.
+type iamPolicyEvaluationPolicies struct {
+	IdentityPolicies       []*IAMPolicyDoc
+	ResourcePolicies       []*IAMPolicyDoc
+	PermissionsBoundary    *IAMPolicyDoc
+	ServiceControlPolicies []*IAMPolicyDoc
+}
+
+// iamPolicyEvaluationResult is the result of evaluating a request.
+type iamPolicyEvaluationResult struct {
+	// Decision is one of the iam.PolicyEvaluationDecisionType values.
+	Decision string
+
+	// Statement is the statement which decided the request, if any.
+	Statement *IAMPolicyStatement
+
+	// Origin identifies the policy which decided the request.
+	Origin string
+}
+
+// evaluateIAMPolicies evaluates a request against a set of policies following the IAM policy evaluation logic
+// for requests within a single account. An explicit Deny in any policy denies the request.
+// Otherwise each service control policy, one per level of the organization, must Allow the request.
+// An Allow in a resource policy then allows the request, otherwise the permissions boundary, if any,
+// and an identity policy must Allow the request. Requests not allowed are implicitly denied.
+func evaluateIAMPolicies(req *iamPolicyEvaluationRequest, policies *iamPolicyEvaluationPolicies) *iamPolicyEvaluationResult {
+	var boundaries []*IAMPolicyDoc
+
+	if policies.PermissionsBoundary != nil {
+		boundaries = append(boundaries, policies.PermissionsBoundary)
+	}
+
+	for _, docs := range [][]*IAMPolicyDoc{policies.ServiceControlPolicies, boundaries, policies.IdentityPolicies} {
+		if stmt := req.matchingStatement(docs, "Deny", false); stmt != nil {
+			return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeExplicitDeny, Statement: stmt, Origin: stmt.origin}
+		}
+	}
+
+	if stmt := req.matchingStatement(policies.ResourcePolicies, "Deny", true); stmt != nil {
+		return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeExplicitDeny, Statement: stmt, Origin: stmt.origin}
+	}
+
+	for _, doc := range policies.ServiceControlPolicies {
+		if req.matchingStatement([]*IAMPolicyDoc{doc}, "Allow", false) != nil {
+			continue
+		}
+
+		origin := "service_control_policies"
+
+		if len(doc.Statements) > 0 && doc.Statements[0] != nil {
+			origin = doc.Statements[0].origin
+		}
+
+		return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeImplicitDeny, Origin: origin}
+	}
+
+	if stmt := req.matchingStatement(policies.ResourcePolicies, "Allow", true); stmt != nil {
+		return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeAllowed, Statement: stmt, Origin: stmt.origin}
+	}
+
+	if len(boundaries) > 0 && req.matchingStatement(boundaries, "Allow", false) == nil {
+		return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeImplicitDeny, Origin: "permissions_boundary"}
+	}
+
+	if stmt := req.matchingStatement(policies.IdentityPolicies, "Allow", false); stmt != nil {
+		return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeAllowed, Statement: stmt, Origin: stmt.origin}
+	}
+
+	return &iamPolicyEvaluationResult{Decision: iam.PolicyEvaluationDecisionTypeImplicitDeny}
+}
+
+// matchingStatement returns the first statement of the documents with the effect which matches the request, if any.
+// The Principal and NotPrincipal elements are only evaluated for resource policies.
+func (req *iamPolicyEvaluationRequest) matchingStatement(docs []*IAMPolicyDoc, effect string, resourcePolicy bool) *IAMPolicyStatement {
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+
+		for _, stmt := range doc.Statements {
+			if stmt == nil || !strings.EqualFold(stmt.Effect, effect) {
+				continue
+			}
+
+			if req.matchesStatement(stmt, resourcePolicy) {
+				return stmt
+			}
+		}
+	}
+
+	return nil
+}
+
+func (req *iamPolicyEvaluationRequest) matchesStatement(stmt *IAMPolicyStatement, resourcePolicy bool) bool {
+	switch {
+	case stmt.Actions != nil:
+		if !req.matchesActions(stmt.Actions) {
+			return false
+		}
+	case stmt.NotActions != nil:
+		if req.matchesActions(stmt.NotActions) {
+			return false
+		}
+	default:
+		return false
+	}
+
+	// Statements of trust policies have no Resource element.
+	switch {
+	case stmt.Resources != nil:
+		if !req.matchesResources(stmt.Resources) {
+			return false
+		}
+	case stmt.NotResources != nil:
+		if req.matchesResources(stmt.NotResources) {
+			return false
+		}
+	}
+
+	if resourcePolicy {
+		switch {
+		case len(stmt.Principals) > 0:
+			if !req.matchesPrincipals(stmt.Principals) {
+				return false
+			}
+		case len(stmt.NotPrincipals) > 0:
+			if req.matchesPrincipals(stmt.NotPrincipals) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	for _, condition := range stmt.Conditions {
+		if !req.matchesCondition(condition) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (req *iamPolicyEvaluationRequest) matchesActions(v interface{}) bool {
+	for _, action := range iamPolicyStringList(v) {
+		if iamPolicyGlobMatch(strings.ToLower(action), strings.ToLower(req.Action)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (req *iamPolicyEvaluationRequest) matchesResources(v interface{}) bool {
+	for _, resource := range iamPolicyStringList(v) {
+		resource, ok := req.substituteVariables(resource)
+
+		if !ok {
+			continue
+		}
+
+		if iamPolicyGlobMatch(resource, req.Resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (req *iamPolicyEvaluationRequest) matchesPrincipals(ps IAMPolicyStatementPrincipalSet) bool {
+	for _, p := range ps {
+		for _, identifier := range iamPolicyStringList(p.Identifiers) {
+			if identifier == "*" || identifier == req.Principal {
+				return true
+			}
+
+			if p.Type != "AWS" {
+				continue
+			}
+
+			// An account ID or account root user ARN matches all principals in the account.
+			principalARN, err := arn.Parse(req.Principal)
+
+			if err != nil || principalARN.AccountID == "" {
+				continue
+			}
+
+			rootARN := arn.ARN{
+				Partition: principalARN.Partition,
+				Service:   "iam",
+				AccountID: principalARN.AccountID,
+				Resource:  "root",
+			}
+
+			if identifier == principalARN.AccountID || identifier == rootARN.String() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesCondition evaluates a condition against the request context.
+// A condition matches if any of the context values matches any of the condition values,
+// unless a ForAllValues: or ForAnyValue: set operator is used.
+func (req *iamPolicyEvaluationRequest) matchesCondition(condition IAMPolicyStatementCondition) bool {
+	operator := strings.ToLower(condition.Test)
+	forAllValues := strings.HasPrefix(operator, "forallvalues:")
+	forAnyValue := strings.HasPrefix(operator, "foranyvalue:")
+	operator = operator[strings.Index(operator, ":")+1:]
+	ifExists := strings.HasSuffix(operator, "ifexists")
+	operator = strings.TrimSuffix(operator, "ifexists")
+
+	contextValues, present := req.Context[strings.ToLower(condition.Variable)]
+	present = present && len(contextValues) > 0
+
+	if operator == "null" {
+		for _, value := range iamPolicyStringList(condition.Values) {
+			if isNull, err := strconv.ParseBool(value); err == nil && isNull != present {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	comparison, ok := iamPolicyConditionComparisons[operator]
+
+	if !ok {
+		return false
+	}
+
+	if !present {
+		return ifExists || forAllValues || (comparison.negated && !forAnyValue)
+	}
+
+	var values []string
+
+	for _, value := range iamPolicyStringList(condition.Values) {
+		if value, ok := req.substituteVariables(value); ok {
+			values = append(values, value)
+		}
+	}
+
+	matchesValue := func(contextValue string) bool {
+		for _, value := range values {
+			if comparison.compare(contextValue, value) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch {
+	case forAllValues:
+		for _, contextValue := range contextValues {
+			if matchesValue(contextValue) == comparison.negated {
+				return false
+			}
+		}
+
+		return true
+	case forAnyValue:
+		for _, contextValue := range contextValues {
+			if matchesValue(contextValue) != comparison.negated {
+				return true
+			}
+		}
+
+		return false
+	default:
+		for _, contextValue := range contextValues {
+			if matchesValue(contextValue) {
+				return !comparison.negated
+			}
+		}
+
+		return comparison.negated
+	}
+}
+
+// substituteVariables replaces policy variables, e.g. ${aws:username} or ${aws:username, 'default'},
+// with their value in the request context. Substitution fails if a variable has no single value and no default.
+func (req *iamPolicyEvaluationRequest) substituteVariables(s string) (string, bool) {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(s, "${")
+
+		if start < 0 {
+			break
+		}
+
+		end := strings.IndexByte(s[start:], '}')
+
+		if end < 0 {
+			break
+		}
+
+		value, ok := req.variable(s[start+2 : start+end])
+
+		if !ok {
+			return "", false
+		}
+
+		sb.WriteString(s[:start])
+		sb.WriteString(value)
+		s = s[start+end+1:]
+	}
+
+	sb.WriteString(s)
+
+	return sb.String(), true
+}
+
+func (req *iamPolicyEvaluationRequest) variable(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+
+	switch name {
+	case "*", "?", "$":
+		return name, true
+	}
+
+	var defaultValue *string
+
+	if i := strings.IndexByte(name, ','); i >= 0 {
+		v := strings.TrimSpace(name[i+1:])
+		v = strings.TrimSuffix(strings.TrimPrefix(v, "'"), "'")
+		defaultValue = &v
+		name = strings.TrimSpace(name[:i])
+	}
+
+	if values := req.Context[strings.ToLower(name)]; len(values) == 1 {
+		return values[0], true
+	}
+
+	if defaultValue != nil {
+		return *defaultValue, true
+	}
+
+	return "", false
+}
+
+// iamPolicyGlobMatch returns whether value matches pattern,
+// in which * matches any sequence of characters and ? matches any single character.
+func iamPolicyGlobMatch(pattern, value string) bool {
+	p, v := 0, 0
+	star, next := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, v
+			p++
+		case star >= 0:
+			next++
+			p, v = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+type iamPolicyConditionComparison struct {
+	// compare returns whether the context value matches the condition value.
+	compare func(contextValue, value string) bool
+
+	// negated is whether the operator matches context values not matching any condition value.
+	negated bool
+}
+
+// iamPolicyConditionComparisons are keyed by lower case condition operator, without set operator prefix or IfExists suffix.
+var iamPolicyConditionComparisons = map[string]iamPolicyConditionComparison{
+	"arnequals":                 {compare: iamPolicyConditionLike},
+	"arnlike":                   {compare: iamPolicyConditionLike},
+	"arnnotequals":              {compare: iamPolicyConditionLike, negated: true},
+	"arnnotlike":                {compare: iamPolicyConditionLike, negated: true},
+	"binaryequals":              {compare: iamPolicyConditionEquals},
+	"bool":                      {compare: strings.EqualFold},
+	"dateequals":                {compare: iamPolicyConditionDates(func(c int) bool { return c == 0 })},
+	"dategreaterthan":           {compare: iamPolicyConditionDates(func(c int) bool { return c > 0 })},
+	"dategreaterthanequals":     {compare: iamPolicyConditionDates(func(c int) bool { return c >= 0 })},
+	"datelessthan":              {compare: iamPolicyConditionDates(func(c int) bool { return c < 0 })},
+	"datelessthanequals":        {compare: iamPolicyConditionDates(func(c int) bool { return c <= 0 })},
+	"datenotequals":             {compare: iamPolicyConditionDates(func(c int) bool { return c == 0 }), negated: true},
+	"ipaddress":                 {compare: iamPolicyConditionIPAddress},
+	"notipaddress":              {compare: iamPolicyConditionIPAddress, negated: true},
+	"numericequals":             {compare: iamPolicyConditionNumbers(func(c int) bool { return c == 0 })},
+	"numericgreaterthan":        {compare: iamPolicyConditionNumbers(func(c int) bool { return c > 0 })},
+	"numericgreaterthanequals":  {compare: iamPolicyConditionNumbers(func(c int) bool { return c >= 0 })},
+	"numericlessthan":           {compare: iamPolicyConditionNumbers(func(c int) bool { return c < 0 })},
+	"numericlessthanequals":     {compare: iamPolicyConditionNumbers(func(c int) bool { return c <= 0 })},
+	"numericnotequals":          {compare: iamPolicyConditionNumbers(func(c int) bool { return c == 0 }), negated: true},
+	"stringequals":              {compare: iamPolicyConditionEquals},
+	"stringequalsignorecase":    {compare: strings.EqualFold},
+	"stringlike":                {compare: iamPolicyConditionLike},
+	"stringnotequals":           {compare: iamPolicyConditionEquals, negated: true},
+	"stringnotequalsignorecase": {compare: strings.EqualFold, negated: true},
+	"stringnotlike":             {compare: iamPolicyConditionLike, negated: true},
+}
+
+func iamPolicyConditionEquals(contextValue, value string) bool {
+	return contextValue == value
+}
+
+func iamPolicyConditionLike(contextValue, value string) bool {
+	return iamPolicyGlobMatch(value, contextValue)
+}
+
+func iamPolicyConditionIPAddress(contextValue, value string) bool {
+	ip := net.ParseIP(contextValue)
+
+	if ip == nil {
+		return false
+	}
+
+	if !strings.Contains(value, "/") {
+		return ip.Equal(net.ParseIP(value))
+	}
+
+	_, ipNet, err := net.ParseCIDR(value)
+
+	return err == nil && ipNet.Contains(ip)
+}
+
+// iamPolicyConditionNumbers returns a comparison of numeric values. Values which are not numbers never match.
+func iamPolicyConditionNumbers(test func(int) bool) func(string, string) bool {
+	return func(contextValue, value string) bool {
+		x, err := strconv.ParseFloat(contextValue, 64)
+
+		if err != nil {
+			return false
+		}
+
+		y, err := strconv.ParseFloat(value, 64)
+
+		if err != nil {
+			return false
+		}
+
+		switch {
+		case x < y:
+			return test(-1)
+		case x > y:
+			return test(1)
+		default:
+			return test(0)
+		}
+	}
+}
+
+// iamPolicyConditionDates returns a comparison of date values. Values which are not dates never match.
+func iamPolicyConditionDates(test func(int) bool) func(string, string) bool {
+	return func(contextValue, value string) bool {
+		x, ok := iamPolicyConditionParseDate(contextValue)
+
+		if !ok {
+			return false
+		}
+
+		y, ok := iamPolicyConditionParseDate(value)
+
+		if !ok {
+			return false
+		}
+
+		switch {
+		case x.Before(y):
+			return test(-1)
+		case x.After(y):
+			return test(1)
+		default:
+			return test(0)
+		}
+	}
+}
+
+// iamPolicyConditionParseDate parses an ISO 8601 date or date and time, or an epoch time in seconds.
+func iamPolicyConditionParseDate(v string) (time.Time, bool) {
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(seconds, 0), true
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+func TestEvaluateIAMPolicies(t *testing.T) {
+	identityPolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "ReadHome",
+      "Effect": "Allow",
+      "Action": ["s3:Get*", "s3:List*"],
+      "Resource": "arn:aws:s3:::bucket/home/${aws:username}/*"
+    },
+    {
+      "Sid": "ReadReports",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::bucket/reports/*",
+      "Condition": {"IpAddress": {"aws:SourceIp": "10.0.0.0/8"}}
+    },
+    {
+      "Sid": "DenyInsecure",
+      "Effect": "Deny",
+      "Action": "s3:*",
+      "Resource": "*",
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}`
+	resourcePolicy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AccountWrite",
+      "Effect": "Allow",
+      "Principal": {"AWS": "123456789012"},
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::bucket/uploads/*"
+    }
+  ]
+}`
+	permissionsBoundary := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "S3Only",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": "*"
+    }
+  ]
+}`
+	scp := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowAll",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenyOutsideRegions",
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "Resource": "*",
+      "Condition": {"StringNotEquals": {"aws:RequestedRegion": ["us-east-1", "us-west-2"]}}
+    }
+  ]
+}`
+	scpNoS3 := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["ec2:*","iam:*"],"Resource":"*"}]}`
+
+	testCases := []struct {
+		Name                   string
+		Request                iamPolicyEvaluationRequest
+		ServiceControlPolicies []string
+		ExpectedDecision       string
+		ExpectedOrigin         string
+		ExpectedSid            string
+	}{
+		{
+			Name: "identity allow with policy variable",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/alice/notes.txt",
+				Context:  map[string][]string{"aws:username": {"alice"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedOrigin:   "identity_policies.0",
+			ExpectedSid:      "ReadHome",
+		},
+		{
+			Name: "policy variable for another user",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/bob/notes.txt",
+				Context:  map[string][]string{"aws:username": {"alice"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name: "missing policy variable",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/alice/notes.txt",
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name: "case insensitive action",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "S3:listbucket",
+				Resource: "arn:aws:s3:::bucket/home/alice/",
+				Context:  map[string][]string{"aws:username": {"alice"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedOrigin:   "identity_policies.0",
+			ExpectedSid:      "ReadHome",
+		},
+		{
+			Name: "ip address condition",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/reports/2021.csv",
+				Context:  map[string][]string{"aws:sourceip": {"10.1.2.3"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedOrigin:   "identity_policies.0",
+			ExpectedSid:      "ReadReports",
+		},
+		{
+			Name: "ip address condition not matched",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/reports/2021.csv",
+				Context:  map[string][]string{"aws:sourceip": {"192.0.2.1"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name: "explicit deny",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/alice/notes.txt",
+				Context:  map[string][]string{"aws:username": {"alice"}, "aws:securetransport": {"false"}},
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedOrigin:   "identity_policies.0",
+			ExpectedSid:      "DenyInsecure",
+		},
+		{
+			Name: "resource policy allow for account principal",
+			Request: iamPolicyEvaluationRequest{
+				Principal: "arn:aws:iam::123456789012:role/uploader",
+				Action:    "s3:PutObject",
+				Resource:  "arn:aws:s3:::bucket/uploads/file",
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedOrigin:   "resource_policies.0",
+			ExpectedSid:      "AccountWrite",
+		},
+		{
+			Name: "resource policy principal from another account",
+			Request: iamPolicyEvaluationRequest{
+				Principal: "arn:aws:iam::210987654321:role/uploader",
+				Action:    "s3:PutObject",
+				Resource:  "arn:aws:s3:::bucket/uploads/file",
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+		},
+		{
+			Name: "permissions boundary",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "sqs:SendMessage",
+				Resource: "arn:aws:sqs:us-east-1:123456789012:queue",
+			},
+			ExpectedDecision: iam.PolicyEvaluationDecisionTypeImplicitDeny,
+			ExpectedOrigin:   "permissions_boundary",
+		},
+		{
+			Name: "service control policy deny",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/alice/notes.txt",
+				Context:  map[string][]string{"aws:username": {"alice"}, "aws:requestedregion": {"eu-west-1"}},
+			},
+			ServiceControlPolicies: []string{scp},
+			ExpectedDecision:       iam.PolicyEvaluationDecisionTypeExplicitDeny,
+			ExpectedOrigin:         "service_control_policies.0",
+			ExpectedSid:            "DenyOutsideRegions",
+		},
+		{
+			Name: "service control policy allow",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/alice/notes.txt",
+				Context:  map[string][]string{"aws:username": {"alice"}, "aws:requestedregion": {"us-west-2"}},
+			},
+			ServiceControlPolicies: []string{scp},
+			ExpectedDecision:       iam.PolicyEvaluationDecisionTypeAllowed,
+			ExpectedOrigin:         "identity_policies.0",
+			ExpectedSid:            "ReadHome",
+		},
+		{
+			Name: "service control policy without allow",
+			Request: iamPolicyEvaluationRequest{
+				Action:   "s3:GetObject",
+				Resource: "arn:aws:s3:::bucket/home/alice/notes.txt",
+				Context:  map[string][]string{"aws:username": {"alice"}, "aws:requestedregion": {"us-west-2"}},
+			},
+			ServiceControlPolicies: []string{scp, scpNoS3},
+			ExpectedDecision:       iam.PolicyEvaluationDecisionTypeImplicitDeny,
+			ExpectedOrigin:         "service_control_policies.1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			policies := &iamPolicyEvaluationPolicies{
+				IdentityPolicies:    []*IAMPolicyDoc{testDecodeIAMPolicyDoc(t, identityPolicy, "identity_policies.0")},
+				ResourcePolicies:    []*IAMPolicyDoc{testDecodeIAMPolicyDoc(t, resourcePolicy, "resource_policies.0")},
+				PermissionsBoundary: testDecodeIAMPolicyDoc(t, permissionsBoundary, "permissions_boundary"),
+			}
+
+			for i, policy := range testCase.ServiceControlPolicies {
+				policies.ServiceControlPolicies = append(policies.ServiceControlPolicies, testDecodeIAMPolicyDoc(t, policy, fmt.Sprintf("service_control_policies.%d", i)))
+			}
+
+			result := evaluateIAMPolicies(&testCase.Request, policies)
+
+			if result.Decision != testCase.ExpectedDecision {
+				t.Errorf("got decision %q, expected %q", result.Decision, testCase.ExpectedDecision)
+			}
+
+			if result.Origin != testCase.ExpectedOrigin {
+				t.Errorf("got origin %q, expected %q", result.Origin, testCase.ExpectedOrigin)
+			}
+
+			var sid string
+
+			if result.Statement != nil {
+				sid = result.Statement.Sid
+			}
+
+			if sid != testCase.ExpectedSid {
+				t.Errorf("got Sid %q, expected %q", sid, testCase.ExpectedSid)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyEvaluationRequestMatchesCondition(t *testing.T) {
+	req := &iamPolicyEvaluationRequest{
+		Context: map[string][]string{
+			"aws:currenttime":       {"2021-03-01T12:00:00Z"},
+			"aws:principaltag/team": {"platform"},
+			"aws:tagkeys":           {"Name", "Owner"},
+			"s3:max-keys":           {"10"},
+			"aws:username":          {"alice"},
+		},
+	}
+
+	testCases := []struct {
+		Test     string
+		Variable string
+		Values   []string
+		Expected bool
+	}{
+		{Test: "StringEquals", Variable: "aws:PrincipalTag/team", Values: []string{"platform"}, Expected: true},
+		{Test: "StringEquals", Variable: "aws:PrincipalTag/team", Values: []string{"Platform"}, Expected: false},
+		{Test: "StringEqualsIgnoreCase", Variable: "aws:PrincipalTag/team", Values: []string{"Platform"}, Expected: true},
+		{Test: "StringNotEquals", Variable: "aws:PrincipalTag/team", Values: []string{"security"}, Expected: true},
+		{Test: "StringNotEquals", Variable: "aws:PrincipalTag/missing", Values: []string{"security"}, Expected: true},
+		{Test: "StringEquals", Variable: "aws:PrincipalTag/missing", Values: []string{"security"}, Expected: false},
+		{Test: "StringEqualsIfExists", Variable: "aws:PrincipalTag/missing", Values: []string{"security"}, Expected: true},
+		{Test: "StringLike", Variable: "aws:PrincipalTag/team", Values: []string{"plat*"}, Expected: true},
+		{Test: "StringLike", Variable: "aws:PrincipalTag/team", Values: []string{"${aws:username}"}, Expected: false},
+		{Test: "StringNotLike", Variable: "aws:PrincipalTag/team", Values: []string{"sec*"}, Expected: true},
+		{Test: "NumericLessThan", Variable: "s3:max-keys", Values: []string{"100"}, Expected: true},
+		{Test: "NumericGreaterThan", Variable: "s3:max-keys", Values: []string{"100"}, Expected: false},
+		{Test: "NumericEquals", Variable: "s3:max-keys", Values: []string{"ten"}, Expected: false},
+		{Test: "DateGreaterThan", Variable: "aws:CurrentTime", Values: []string{"2021-01-01"}, Expected: true},
+		{Test: "DateLessThan", Variable: "aws:CurrentTime", Values: []string{"1609459200"}, Expected: false},
+		{Test: "Null", Variable: "aws:PrincipalTag/missing", Values: []string{"true"}, Expected: true},
+		{Test: "Null", Variable: "aws:PrincipalTag/team", Values: []string{"true"}, Expected: false},
+		{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"Name", "Owner", "Project"}, Expected: true},
+		{Test: "ForAllValues:StringEquals", Variable: "aws:TagKeys", Values: []string{"Name"}, Expected: false},
+		{Test: "ForAllValues:StringEquals", Variable: "aws:MissingKeys", Values: []string{"Name"}, Expected: true},
+		{Test: "ForAnyValue:StringEquals", Variable: "aws:TagKeys", Values: []string{"Owner"}, Expected: true},
+		{Test: "ForAnyValue:StringEquals", Variable: "aws:MissingKeys", Values: []string{"Owner"}, Expected: false},
+		{Test: "StringNotEqualsIgnoreCasee", Variable: "aws:PrincipalTag/team", Values: []string{"security"}, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		condition := IAMPolicyStatementCondition{Test: testCase.Test, Variable: testCase.Variable, Values: testCase.Values}
+
+		if got := req.matchesCondition(condition); got != testCase.Expected {
+			t.Errorf("%s %s %v: got %t, expected %t", testCase.Test, testCase.Variable, testCase.Values, got, testCase.Expected)
+		}
+	}
+}
+
+func TestIAMPolicyGlobMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Value    string
+		Expected bool
+	}{
+		{Pattern: "*", Value: "", Expected: true},
+		{Pattern: "*", Value: "anything", Expected: true},
+		{Pattern: "s3:Get*", Value: "s3:GetObject", Expected: true},
+		{Pattern: "s3:Get*", Value: "s3:PutObject", Expected: false},
+		{Pattern: "s3:*Object", Value: "s3:GetObjectAcl", Expected: false},
+		{Pattern: "s3:*Object*", Value: "s3:GetObjectAcl", Expected: true},
+		{Pattern: "arn:aws:s3:::bucket/?", Value: "arn:aws:s3:::bucket/a", Expected: true},
+		{Pattern: "arn:aws:s3:::bucket/?", Value: "arn:aws:s3:::bucket/ab", Expected: false},
+		{Pattern: "a*b*c", Value: "aXbYbZc", Expected: true},
+		{Pattern: "a*b*c", Value: "aXbYbZ", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := iamPolicyGlobMatch(testCase.Pattern, testCase.Value); got != testCase.Expected {
+			t.Errorf("iamPolicyGlobMatch(%q, %q) = %t, expected %t", testCase.Pattern, testCase.Value, got, testCase.Expected)
+		}
+	}
+}
+
+func testDecodeIAMPolicyDoc(t *testing.T, policy, origin string) *IAMPolicyDoc {
+	doc, err := dataSourceAwsIamPolicyDocumentDecode(policy, origin)
+
+	if err != nil {
+		t.Fatalf("error decoding %s: %s", origin, err)
+	}
+
+	return doc
+}
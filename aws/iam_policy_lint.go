@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/catalog"
+)
+
+// iamPolicyConditionOperators are the base IAM condition operators, without
+// ForAllValues:/ForAnyValue: set operator prefix or IfExists suffix.
+var iamPolicyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+// lintIAMPolicyJson parses an IAM policy document and lints it.
+// Documents which cannot be parsed into IAMPolicyDoc are not linted.
+func lintIAMPolicyJson(policy string, k string) (ws []string, errors []error) {
+	var doc IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, nil
+	}
+
+	return lintIAMPolicyDoc(&doc, k)
+}
+
+// lintIAMPolicyDoc validates an IAM policy document against the IAM catalog.
+// Findings which depend on the catalog, such as unknown actions, are returned as warnings
+// as the catalog may not include actions released since it was last updated.
+// Findings which are invalid regardless of the catalog, such as malformed condition operators, are returned as errors.
+func lintIAMPolicyDoc(doc *IAMPolicyDoc, k string) (ws []string, errors []error) {
+	for i, stmt := range doc.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		stmtKey := fmt.Sprintf("%s Statement[%d]", k, i)
+
+		if stmt.Sid != "" {
+			stmtKey = fmt.Sprintf("%s Statement %q", k, stmt.Sid)
+		}
+
+		stmtWs, stmtErrors := lintIAMPolicyStatement(stmt, stmtKey)
+		ws = append(ws, stmtWs...)
+		errors = append(errors, stmtErrors...)
+	}
+
+	return ws, errors
+}
+
+func lintIAMPolicyStatement(stmt *IAMPolicyStatement, k string) (ws []string, errors []error) {
+	add := func(elemWs []string, elemErrors []error) {
+		ws = append(ws, elemWs...)
+		errors = append(errors, elemErrors...)
+	}
+
+	for _, action := range iamPolicyStringList(stmt.Actions) {
+		add(validateIAMPolicyAction(action, k+" Action"))
+	}
+
+	for _, action := range iamPolicyStringList(stmt.NotActions) {
+		add(validateIAMPolicyAction(action, k+" NotAction"))
+	}
+
+	for _, resource := range iamPolicyStringList(stmt.Resources) {
+		add(validateIAMPolicyResource(resource, k+" Resource"))
+	}
+
+	for _, resource := range iamPolicyStringList(stmt.NotResources) {
+		add(validateIAMPolicyResource(resource, k+" NotResource"))
+	}
+
+	for _, condition := range stmt.Conditions {
+		add(validateIAMPolicyConditionOperator(condition.Test, k+" Condition"))
+		add(validateIAMPolicyConditionKey(condition.Variable, k+" Condition"))
+	}
+
+	return ws, errors
+}
+
+// validateIAMPolicyAction validates an IAM policy action, e.g. s3:GetObject.
+func validateIAMPolicyAction(v interface{}, k string) (ws []string, errors []error) {
+	action := v.(string)
+
+	if action == "*" {
+		return
+	}
+
+	parts := strings.SplitN(action, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		errors = append(errors, fmt.Errorf("%s: action %q must be of the form service:action", k, action))
+		return
+	}
+
+	prefix, name := parts[0], parts[1]
+
+	if strings.ContainsAny(prefix, "*?") {
+		return
+	}
+
+	service, ok := catalog.LookupService(prefix)
+
+	if !ok {
+		return
+	}
+
+	if len(service.MatchActions(name)) == 0 {
+		ws = append(ws, fmt.Sprintf("%s: action %q matches no known %s actions (IAM catalog version %s)", k, action, service.Name, catalog.Version))
+	}
+
+	return
+}
+
+// validateIAMPolicyResource validates an IAM policy resource ARN pattern.
+func validateIAMPolicyResource(v interface{}, k string) (ws []string, errors []error) {
+	resource := v.(string)
+
+	if resource == "*" {
+		return
+	}
+
+	parts := strings.SplitN(resource, ":", 6)
+
+	if len(parts) < 3 || parts[0] != "arn" {
+		ws = append(ws, fmt.Sprintf("%s: resource %q is not an ARN or *", k, resource))
+		return
+	}
+
+	if strings.ContainsAny(parts[2], "*?${") {
+		return
+	}
+
+	service, ok := catalog.LookupService(parts[2])
+
+	if !ok {
+		if len(parts) != 6 {
+			ws = append(ws, fmt.Sprintf("%s: resource %q is not an ARN or *", k, resource))
+		}
+
+		return
+	}
+
+	if !service.MatchesResourceARN(resource) {
+		ws = append(ws, fmt.Sprintf("%s: resource %q can never match a %s resource ARN (IAM catalog version %s)", k, resource, service.Name, catalog.Version))
+	}
+
+	return
+}
+
+// validateIAMPolicyConditionOperator validates an IAM policy condition operator, e.g. ForAnyValue:StringLikeIfExists.
+func validateIAMPolicyConditionOperator(v interface{}, k string) (ws []string, errors []error) {
+	operator := v.(string)
+	base := operator
+
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if len(base) > len(prefix) && strings.EqualFold(base[:len(prefix)], prefix) {
+			base = base[len(prefix):]
+			break
+		}
+	}
+
+	ifExists := false
+
+	if suffix := "IfExists"; len(base) > len(suffix) && strings.EqualFold(base[len(base)-len(suffix):], suffix) {
+		base = base[:len(base)-len(suffix)]
+		ifExists = true
+	}
+
+	for _, o := range iamPolicyConditionOperators {
+		if !strings.EqualFold(base, o) {
+			continue
+		}
+
+		if ifExists && o == "Null" {
+			break
+		}
+
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%s: invalid condition operator %q", k, operator))
+
+	return
+}
+
+// validateIAMPolicyConditionKey validates an IAM policy condition key, e.g. aws:SourceIp.
+func validateIAMPolicyConditionKey(v interface{}, k string) (ws []string, errors []error) {
+	key := v.(string)
+	parts := strings.SplitN(key, ":", 2)
+
+	if len(parts) != 2 {
+		return
+	}
+
+	prefix := strings.ToLower(parts[0])
+
+	if prefix == "aws" {
+		if !catalog.IsGlobalConditionKey(key) {
+			ws = append(ws, fmt.Sprintf("%s: condition key %q is not a known global condition key (IAM catalog version %s)", k, key, catalog.Version))
+		}
+
+		return
+	}
+
+	service, ok := catalog.LookupService(prefix)
+
+	if !ok {
+		return
+	}
+
+	if !service.HasConditionKey(key) {
+		ws = append(ws, fmt.Sprintf("%s: condition key %q is not a known %s condition key (IAM catalog version %s)", k, key, service.Name, catalog.Version))
+	}
+
+	return
+}
+
+// iamPolicyStringList returns the values of an IAM policy element which may be a string or list of strings.
+func iamPolicyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var l []string
+
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				l = append(l, s)
+			}
+		}
+
+		return l
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintIAMPolicyJson(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Policy           string
+		ExpectedWarnings []string
+		ExpectedErrors   []string
+	}{
+		{
+			Name:   "not a policy",
+			Policy: `{"abc":["1","2"]}`,
+		},
+		{
+			Name:   "single statement object",
+			Policy: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}}`,
+		},
+		{
+			Name: "valid",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*", "sqs:sendmessage", "ec2:DescribeInstances", "*"],
+      "Resource": ["arn:aws:s3:::my-bucket", "arn:aws:s3:::my-bucket/${aws:username}/*", "arn:aws:sqs:*:*:queue", "*"],
+      "Condition": {
+        "ForAnyValue:StringLikeIfExists": {"aws:PrincipalTag/team": "a*"},
+        "StringEquals": {"s3:prefix": "home/"},
+        "Bool": {"aws:SecureTransport": "true"},
+        "StringLike": {"ec2:ResourceTag/Name": "*"}
+      }
+    }
+  ]
+}`,
+		},
+		{
+			Name: "unknown actions",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Typo",
+      "Effect": "Allow",
+      "Action": ["s3:GetObjects", "sqs:Send*Messages"],
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "NotAction": "dynamodb:Query*Items",
+      "Resource": "*"
+    }
+  ]
+}`,
+			ExpectedWarnings: []string{
+				`policy Statement "Typo" Action: action "s3:GetObjects" matches no known`,
+				`policy Statement "Typo" Action: action "sqs:Send*Messages" matches no known`,
+				`policy Statement[1] NotAction: action "dynamodb:Query*Items" matches no known`,
+			},
+		},
+		{
+			Name:   "malformed action",
+			Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"GetObject","Resource":"*"}]}`,
+			ExpectedErrors: []string{
+				`policy Statement[0] Action: action "GetObject" must be of the form service:action`,
+			},
+		},
+		{
+			Name:   "resources",
+			Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":["arn:aws:s3::my-bucket","my-bucket","arn:aws:ec2:*:*:anything"]}]}`,
+			ExpectedWarnings: []string{
+				`policy Statement[0] Resource: resource "arn:aws:s3::my-bucket" can never match`,
+				`policy Statement[0] Resource: resource "my-bucket" is not an ARN or *`,
+			},
+		},
+		{
+			Name:   "conditions",
+			Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEqual":{"aws:SourceVpce":"vpce-1"},"NullIfExists":{"s3:prefixes":"true"}}}]}`,
+			ExpectedWarnings: []string{
+				`policy Statement[0] Condition: condition key "s3:prefixes" is not a known`,
+			},
+			ExpectedErrors: []string{
+				`policy Statement[0] Condition: invalid condition operator "NullIfExists"`,
+				`policy Statement[0] Condition: invalid condition operator "StringEqual"`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ws, errors := lintIAMPolicyJson(testCase.Policy, "policy")
+
+			if got, expected := len(ws), len(testCase.ExpectedWarnings); got != expected {
+				t.Errorf("got %d warnings, expected %d: %v", got, expected, ws)
+			}
+
+			for _, expected := range testCase.ExpectedWarnings {
+				found := false
+
+				for _, w := range ws {
+					if strings.HasPrefix(w, expected) {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					t.Errorf("expected warning %q, got: %v", expected, ws)
+				}
+			}
+
+			if got, expected := len(errors), len(testCase.ExpectedErrors); got != expected {
+				t.Errorf("got %d errors, expected %d: %v", got, expected, errors)
+			}
+
+			for _, expected := range testCase.ExpectedErrors {
+				found := false
+
+				for _, err := range errors {
+					if strings.HasPrefix(err.Error(), expected) {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					t.Errorf("expected error %q, got: %v", expected, errors)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateIAMPolicyConditionOperator(t *testing.T) {
+	for _, operator := range []string{"StringEquals", "stringlike", "ArnLikeIfExists", "ForAllValues:StringEquals", "ForAnyValue:NumericLessThanIfExists", "Null"} {
+		if _, errors := validateIAMPolicyConditionOperator(operator, "test"); len(errors) != 0 {
+			t.Errorf("expected %q to be valid, got: %v", operator, errors)
+		}
+	}
+
+	for _, operator := range []string{"", "StringEqual", "IfExists", "ForAnyValue:", "ForSomeValues:StringEquals", "NullIfExists", "StringEqualsIfExistsIfExists"} {
+		if _, errors := validateIAMPolicyConditionOperator(operator, "test"); len(errors) != 1 {
+			t.Errorf("expected %q to be invalid", operator)
+		}
+	}
+}
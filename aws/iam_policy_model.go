@@ -1,9 +1,12 @@
 package aws
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
+
+	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 type IAMPolicyDoc struct {
@@ -22,6 +25,10 @@ type IAMPolicyStatement struct {
 	Principals    IAMPolicyStatementPrincipalSet `json:"Principal,omitempty"`
 	NotPrincipals IAMPolicyStatementPrincipalSet `json:"NotPrincipal,omitempty"`
 	Conditions    IAMPolicyStatementConditionSet `json:"Condition,omitempty"`
+
+	// origin identifies the input document of the statement when composing documents.
+	// It is not part of the policy document.
+	origin string
 }
 
 type IAMPolicyStatementPrincipal struct {
@@ -70,6 +77,187 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// MergeUniqueSids merges newDoc into the document as Merge does, but returns an error
+// rather than overwriting a statement when a non-blank Sid of newDoc already exists in the document.
+func (s *IAMPolicyDoc) MergeUniqueSids(newDoc *IAMPolicyDoc) error {
+	sids := make(map[string]struct{})
+
+	for _, stmt := range s.Statements {
+		if stmt.Sid != "" {
+			sids[stmt.Sid] = struct{}{}
+		}
+	}
+
+	for _, stmt := range newDoc.Statements {
+		if _, ok := sids[stmt.Sid]; ok {
+			return fmt.Errorf("duplicate Sid (%s)", stmt.Sid)
+		}
+	}
+
+	s.Merge(newDoc)
+
+	return nil
+}
+
+// DuplicateSid returns the first non-blank Sid used by more than one statement of the document, if any.
+func (s *IAMPolicyDoc) DuplicateSid() string {
+	sids := make(map[string]struct{})
+
+	for _, stmt := range s.Statements {
+		if stmt.Sid == "" {
+			continue
+		}
+
+		if _, ok := sids[stmt.Sid]; ok {
+			return stmt.Sid
+		}
+
+		sids[stmt.Sid] = struct{}{}
+	}
+
+	return ""
+}
+
+// setStatementOrigins sets the origin of all statements of the document.
+func (s *IAMPolicyDoc) setStatementOrigins(origin string) {
+	for _, stmt := range s.Statements {
+		stmt.origin = origin
+	}
+}
+
+// statementOrigins returns the origin of each statement of the document.
+func (s *IAMPolicyDoc) statementOrigins() []string {
+	origins := make([]string, len(s.Statements))
+
+	for i, stmt := range s.Statements {
+		origins[i] = stmt.origin
+	}
+
+	return origins
+}
+
+// Normalize sorts the statements of the document by Sid, sorts principals, conditions and their values,
+// and collapses single element lists to strings, so that equivalent documents are marshaled identically.
+func (s *IAMPolicyDoc) Normalize() {
+	for _, stmt := range s.Statements {
+		if stmt == nil {
+			continue
+		}
+
+		stmt.Actions = iamPolicyNormalizeStringList(stmt.Actions)
+		stmt.NotActions = iamPolicyNormalizeStringList(stmt.NotActions)
+		stmt.Resources = iamPolicyNormalizeStringList(stmt.Resources)
+		stmt.NotResources = iamPolicyNormalizeStringList(stmt.NotResources)
+		stmt.Principals.normalize()
+		stmt.NotPrincipals.normalize()
+		stmt.Conditions.normalize()
+	}
+
+	sort.SliceStable(s.Statements, func(i, j int) bool {
+		if s.Statements[i] == nil || s.Statements[j] == nil {
+			return false
+		}
+
+		return s.Statements[i].Sid < s.Statements[j].Sid
+	})
+}
+
+func (ps IAMPolicyStatementPrincipalSet) normalize() {
+	for i := range ps {
+		ps[i].Identifiers = iamPolicyNormalizeStringList(ps[i].Identifiers)
+	}
+
+	sort.SliceStable(ps, func(i, j int) bool {
+		return ps[i].Type < ps[j].Type
+	})
+}
+
+func (cs IAMPolicyStatementConditionSet) normalize() {
+	for i := range cs {
+		cs[i].Values = iamPolicyNormalizeStringList(cs[i].Values)
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		if cs[i].Test != cs[j].Test {
+			return cs[i].Test < cs[j].Test
+		}
+
+		return cs[i].Variable < cs[j].Variable
+	})
+}
+
+// iamPolicyNormalizeStringList returns a single string for single element lists
+// and a reverse sorted list, as generated by aws_iam_policy_document, otherwise.
+func iamPolicyNormalizeStringList(v interface{}) interface{} {
+	l := iamPolicyStringList(v)
+
+	switch len(l) {
+	case 0:
+		return v
+	case 1:
+		return l[0]
+	default:
+		sorted := make([]string, len(l))
+		copy(sorted, l)
+		sort.Sort(sort.Reverse(sort.StringSlice(sorted)))
+
+		return sorted
+	}
+}
+
+// normalizeIAMPolicyJson returns the normalized JSON of an IAM policy document (see IAMPolicyDoc.Normalize).
+// Policies which cannot be normalized without changing their meaning, e.g. because they contain
+// elements not modeled by IAMPolicyDoc, are returned unchanged.
+func normalizeIAMPolicyJson(policy string) string {
+	if policy == "" {
+		return policy
+	}
+
+	var doc IAMPolicyDoc
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return policy
+	}
+
+	doc.Normalize()
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(&doc); err != nil {
+		return policy
+	}
+
+	normalized := string(bytes.TrimSpace(buf.Bytes()))
+
+	if equivalent, err := awspolicy.PoliciesAreEquivalent(policy, normalized); err != nil || !equivalent {
+		return policy
+	}
+
+	return normalized
+}
+
+// iamPolicyStateFunc is a StateFunc for IAM policy document attributes.
+// It is used with DiffSuppressFunc suppressEquivalentAwsPolicyDiffs so that plans show
+// minimal differences between normalized documents.
+func iamPolicyStateFunc(v interface{}) string {
+	return normalizeIAMPolicyJson(v.(string))
+}
+
+// iamPolicyToSet returns the value to set in state for an IAM policy document attribute
+// read from AWS. The existing value is kept if the policy is equivalent to it, otherwise
+// the normalized policy is returned.
+func iamPolicyToSet(existing, policy string) string {
+	if existing != "" && policy != "" {
+		if equivalent, err := awspolicy.PoliciesAreEquivalent(existing, policy); err == nil && equivalent {
+			return existing
+		}
+	}
+
+	return normalizeIAMPolicyJson(policy)
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -143,7 +331,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, s)
 				}
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
 			default:
@@ -198,7 +390,11 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementConditionSet.Values", v)
+					}
+					values = append(values, s)
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
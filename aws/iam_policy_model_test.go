@@ -0,0 +1,191 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIAMPolicyDocMergeUniqueSids(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{Sid: "Baseline", Effect: "Allow"},
+			{Effect: "Allow"},
+		},
+	}
+	doc.setStatementOrigins("source.0")
+
+	newDoc := &IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*IAMPolicyStatement{
+			{Sid: "Team", Effect: "Allow"},
+			{Effect: "Deny"},
+		},
+	}
+	newDoc.setStatementOrigins("source.1")
+
+	if err := doc.MergeUniqueSids(newDoc); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := doc.statementOrigins(), []string{"source.0", "source.0", "source.1", "source.1"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got origins %v, expected %v", got, expected)
+	}
+
+	if doc.Version != "2012-10-17" {
+		t.Errorf("got version %q, expected merged version", doc.Version)
+	}
+
+	err := doc.MergeUniqueSids(&IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{Sid: "Team", Effect: "Deny"},
+		},
+	})
+
+	if err == nil || err.Error() != "duplicate Sid (Team)" {
+		t.Errorf("expected duplicate Sid error, got: %v", err)
+	}
+
+	if got := len(doc.Statements); got != 4 {
+		t.Errorf("got %d statements, expected document to be unchanged", got)
+	}
+}
+
+func TestIAMPolicyDocMergeOrigins(t *testing.T) {
+	doc := &IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{Sid: "A", Effect: "Allow"},
+			{Sid: "B", Effect: "Allow"},
+		},
+	}
+	doc.setStatementOrigins("statement")
+
+	override := &IAMPolicyDoc{
+		Statements: []*IAMPolicyStatement{
+			{Sid: "B", Effect: "Deny"},
+			{Sid: "C", Effect: "Deny"},
+		},
+	}
+	override.setStatementOrigins("override")
+
+	doc.Merge(override)
+
+	if got, expected := doc.statementOrigins(), []string{"statement", "override", "override"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got origins %v, expected %v", got, expected)
+	}
+
+	if got := doc.Statements[1].Effect; got != "Deny" {
+		t.Errorf("got effect %q for overridden statement, expected Deny", got)
+	}
+}
+
+func TestIAMPolicyDocDuplicateSid(t *testing.T) {
+	testCases := []struct {
+		Sids     []string
+		Expected string
+	}{
+		{Sids: nil, Expected: ""},
+		{Sids: []string{"", ""}, Expected: ""},
+		{Sids: []string{"A", "B"}, Expected: ""},
+		{Sids: []string{"A", "B", "A"}, Expected: "A"},
+	}
+
+	for _, testCase := range testCases {
+		doc := &IAMPolicyDoc{}
+
+		for _, sid := range testCase.Sids {
+			doc.Statements = append(doc.Statements, &IAMPolicyStatement{Sid: sid})
+		}
+
+		if got := doc.DuplicateSid(); got != testCase.Expected {
+			t.Errorf("DuplicateSid(%v) = %q, expected %q", testCase.Sids, got, testCase.Expected)
+		}
+	}
+}
+
+func TestNormalizeIAMPolicyJson(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Policy   string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Policy:   "",
+			Expected: "",
+		},
+		{
+			Name:     "invalid JSON",
+			Policy:   `{"Version":`,
+			Expected: `{"Version":`,
+		},
+		{
+			Name: "normalized",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "B",
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject"],
+      "Resource": ["arn:aws:s3:::bucket/*"],
+      "Principal": {"Service": ["ec2.amazonaws.com"], "AWS": ["arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:root"]},
+      "Condition": {"StringLike": {"s3:prefix": ["b", "a"]}, "Bool": {"aws:SecureTransport": "true"}}
+    },
+    {
+      "Sid": "A",
+      "Effect": "Deny",
+      "NotAction": "s3:*",
+      "Resource": "*"
+    }
+  ]
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","NotAction":"s3:*","Resource":"*"},{"Sid":"B","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*","Principal":{"AWS":["arn:aws:iam::210987654321:root","arn:aws:iam::123456789012:root"],"Service":"ec2.amazonaws.com"},"Condition":{"Bool":{"aws:SecureTransport":"true"},"StringLike":{"s3:prefix":["b","a"]}}}]}`,
+		},
+		{
+			Name:     "wildcard principal",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sqs:*","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"sqs:*","Resource":"*","Principal":"*"}]}`,
+		},
+		{
+			Name:     "non-string condition value unchanged",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+		},
+		{
+			Name:     "non-string condition list value unchanged",
+			Policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"s3:max-keys":[10, 20]}}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"NumericLessThan":{"s3:max-keys":[10, 20]}}}]}`,
+		},
+		{
+			Name:     "single statement object",
+			Policy:   `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:*","Resource":"*"}}`,
+			Expected: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:*","Resource":"*"}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := normalizeIAMPolicyJson(testCase.Policy); got != testCase.Expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyToSet(t *testing.T) {
+	existing := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`
+	reordered := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["*"]}]}`
+	changed := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject","s3:DeleteObject"],"Resource":["*"]}]}`
+
+	if got := iamPolicyToSet(existing, reordered); got != existing {
+		t.Errorf("got %s, expected existing policy to be kept", got)
+	}
+
+	if got, expected := iamPolicyToSet(existing, changed), normalizeIAMPolicyJson(changed); got != expected {
+		t.Errorf("got %s, expected normalized policy %s", got, expected)
+	}
+
+	if got, expected := iamPolicyToSet("", reordered), normalizeIAMPolicyJson(reordered); got != expected {
+		t.Errorf("got %s, expected normalized policy %s", got, expected)
+	}
+}
@@ -0,0 +1,289 @@
+package apitrace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	// EnvVarPath is the environment variable that sets the path of the trace file.
+	EnvVarPath = "TF_AWS_API_TRACE_PATH"
+
+	// CompleteHandlerName is the name of the request handler added to AWS service clients to trace API calls.
+	CompleteHandlerName = "terraform-provider-aws.apitrace.TracerCompleteHandler"
+
+	// ResourceHandlerName is the name of the request handler added to AWS service clients used by a single resource type.
+	ResourceHandlerName = "terraform-provider-aws.apitrace.ResourceHandler"
+
+	// Redacted replaces the value of request parameters marked sensitive in the AWS API models.
+	Redacted = "<sensitive>"
+)
+
+// Record types.
+const (
+	// RecordTypeAPICall is the type of records of AWS API operations.
+	RecordTypeAPICall = "api_call"
+
+	// RecordTypeResource is the type of records of resource and data source functions, e.g. Read.
+	RecordTypeResource = "resource"
+)
+
+// Record is a single line of the trace file.
+type Record struct {
+	Time       time.Time   `json:"time"`
+	Type       string      `json:"type"`
+	Resource   string      `json:"resource,omitempty"`
+	ResourceID string      `json:"resource_id,omitempty"`
+	Service    string      `json:"service,omitempty"`
+	Operation  string      `json:"operation"`
+	Region     string      `json:"region,omitempty"`
+	DurationMs float64     `json:"duration_ms"`
+	RetryCount int         `json:"retry_count,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	StatusCode int         `json:"status_code,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	Failed     bool        `json:"failed,omitempty"`
+	Params     interface{} `json:"params,omitempty"`
+}
+
+// Tracer writes a JSON record per AWS API call and resource function to a writer.
+// It is safe for concurrent use.
+type Tracer struct {
+	mu  sync.Mutex
+	now func() time.Time
+	w   io.Writer
+}
+
+// New returns a Tracer writing JSON lines to w.
+func New(w io.Writer) *Tracer {
+	return &Tracer{
+		now: time.Now,
+		w:   w,
+	}
+}
+
+// Write writes a record as a single line.
+// Errors are logged rather than failing the traced operation.
+func (t *Tracer) Write(record *Record) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+
+	// Encode terminates the record with a newline.
+	if err := encoder.Encode(record); err != nil {
+		log.Printf("[WARN] Error encoding API trace record: %s", err)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, err := t.w.Write(buf.Bytes()); err != nil {
+		log.Printf("[WARN] Error writing API trace record: %s", err)
+	}
+}
+
+// TraceResource records a call of a resource or data source function, e.g. Read, and its duration.
+// The resource ID is read after the call, so that the ID set by Create is recorded.
+func (t *Tracer) TraceResource(resource, operation string, id func() string, f func() bool) {
+	start := t.now()
+	failed := !f()
+
+	t.Write(&Record{
+		Time:       start.UTC(),
+		Type:       RecordTypeResource,
+		Resource:   resource,
+		ResourceID: id(),
+		Operation:  operation,
+		DurationMs: durationMs(t.now().Sub(start)),
+		Failed:     failed,
+	})
+}
+
+// CompleteHandler returns a request handler, to be added to the back of the Complete handler list
+// of a session or service client, that records each API call once all attempts are complete.
+func (t *Tracer) CompleteHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: CompleteHandlerName,
+		Fn:   t.complete,
+	}
+}
+
+func (t *Tracer) complete(req *request.Request) {
+	record := &Record{
+		Time:       req.Time.UTC(),
+		Type:       RecordTypeAPICall,
+		Resource:   ResourceFromContext(req.Context()),
+		Service:    req.ClientInfo.ServiceID,
+		Region:     req.ClientInfo.SigningRegion,
+		DurationMs: durationMs(t.now().Sub(req.Time)),
+		RetryCount: req.RetryCount,
+		RequestID:  req.RequestID,
+		Params:     Redact(req.Params),
+	}
+
+	if req.Operation != nil {
+		record.Operation = req.Operation.Name
+	}
+
+	if req.HTTPResponse != nil {
+		record.StatusCode = req.HTTPResponse.StatusCode
+	}
+
+	if req.Error != nil {
+		record.Failed = true
+
+		if awsErr, ok := req.Error.(awserr.Error); ok {
+			record.ErrorCode = awsErr.Code()
+		}
+	}
+
+	t.Write(record)
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+type resourceContextKey struct{}
+
+// WithResource returns a context recording the resource type issuing API calls.
+func WithResource(ctx context.Context, resource string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, resource)
+}
+
+// ResourceFromContext returns the resource type issuing API calls recorded in the context, if any.
+func ResourceFromContext(ctx context.Context) string {
+	resource, _ := ctx.Value(resourceContextKey{}).(string)
+
+	return resource
+}
+
+// ResourceHandler returns a request handler, to be added to the front of the Validate handler list
+// of a session or service client used by a single resource type, that records the resource type
+// in the request context.
+func ResourceHandler(resource string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: ResourceHandlerName,
+		Fn: func(req *request.Request) {
+			if ResourceFromContext(req.Context()) == "" {
+				req.SetContext(WithResource(req.Context(), resource))
+			}
+		},
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// unmarkedSensitiveFields lists fields holding secrets that are not tagged sensitive in the AWS API models.
+var unmarkedSensitiveFields = map[reflect.Type]map[string]bool{
+	reflect.TypeOf(ssm.PutParameterInput{}): {"Value": true},
+}
+
+// Redact returns a JSON encodable copy of an AWS API operation input with values of fields tagged
+// sensitive in the AWS API models, or known to hold secrets, replaced, and binary values and streams
+// replaced by placeholders. Unset fields are omitted.
+func Redact(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	return redactValue(reflect.ValueOf(v))
+}
+
+func redactValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		if _, ok := v.Interface().(io.Reader); ok {
+			return "<stream>"
+		}
+
+		return redactValue(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface()
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" || field.Name == "_" {
+				continue
+			}
+
+			value := v.Field(i)
+
+			if isEmpty(value) {
+				continue
+			}
+
+			if field.Tag.Get("sensitive") == "true" || unmarkedSensitiveFields[v.Type()][field.Name] {
+				m[field.Name] = Redacted
+				continue
+			}
+
+			m[field.Name] = redactValue(value)
+		}
+
+		return m
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<binary> len %d", v.Len())
+		}
+
+		l := make([]interface{}, v.Len())
+
+		for i := range l {
+			l[i] = redactValue(v.Index(i))
+		}
+
+		return l
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = redactValue(iter.Value())
+		}
+
+		return m
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	default:
+		return v.Interface()
+	}
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
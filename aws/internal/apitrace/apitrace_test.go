@@ -0,0 +1,210 @@
+package apitrace
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func TestRedact(t *testing.T) {
+	created := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name     string
+		Input    interface{}
+		Expected string
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: `null`,
+		},
+		{
+			Name: "sensitive fields",
+			Input: &secretsmanager.PutSecretValueInput{
+				SecretId:      aws.String("example"),
+				SecretString:  aws.String("hunter2"),
+				VersionStages: aws.StringSlice([]string{"AWSCURRENT"}),
+			},
+			Expected: `{"SecretId":"example","SecretString":"<sensitive>","VersionStages":["AWSCURRENT"]}`,
+		},
+		{
+			Name: "sensitive binary",
+			Input: &secretsmanager.PutSecretValueInput{
+				SecretBinary: []byte("hunter2"),
+				SecretId:     aws.String("example"),
+			},
+			Expected: `{"SecretBinary":"<sensitive>","SecretId":"example"}`,
+		},
+		{
+			Name: "nested sensitive fields",
+			Input: &secretsmanager.CreateSecretInput{
+				Name:         aws.String("example"),
+				SecretString: aws.String("hunter2"),
+				Tags: []*secretsmanager.Tag{
+					{Key: aws.String("Name"), Value: aws.String("example")},
+				},
+			},
+			Expected: `{"Name":"example","SecretString":"<sensitive>","Tags":[{"Key":"Name","Value":"example"}]}`,
+		},
+		{
+			Name: "unmarked sensitive fields",
+			Input: &ssm.PutParameterInput{
+				Name:  aws.String("/example"),
+				Type:  aws.String(ssm.ParameterTypeSecureString),
+				Value: aws.String("hunter2"),
+			},
+			Expected: `{"Name":"/example","Type":"SecureString","Value":"<sensitive>"}`,
+		},
+		{
+			Name: "streams, maps and times",
+			Input: &s3.PutObjectInput{
+				Body:                      bytes.NewReader([]byte("content")),
+				Bucket:                    aws.String("example"),
+				Key:                       aws.String("key"),
+				Metadata:                  aws.StringMap(map[string]string{"owner": "example"}),
+				ObjectLockRetainUntilDate: aws.Time(created),
+				SSECustomerKey:            aws.String("key"),
+			},
+			Expected: `{"Body":"<stream>","Bucket":"example","Key":"key","Metadata":{"owner":"example"},"ObjectLockRetainUntilDate":"2021-03-01T00:00:00Z","SSECustomerKey":"<sensitive>"}`,
+		},
+		{
+			Name: "binary",
+			Input: &struct {
+				Blob []byte
+				_    struct{} `type:"structure"`
+			}{
+				Blob: []byte("content"),
+			},
+			Expected: `{"Blob":"<binary> len 7"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			encoder := json.NewEncoder(buf)
+			encoder.SetEscapeHTML(false)
+
+			if err := encoder.Encode(Redact(testCase.Input)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := strings.TrimSpace(buf.String()); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestTracerCompleteHandler(t *testing.T) {
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	buf := &bytes.Buffer{}
+	tracer := New(buf)
+	tracer.now = func() time.Time { return start.Add(1500 * time.Microsecond) }
+
+	handlers := request.Handlers{}
+	handlers.Validate.PushFrontNamed(ResourceHandler("aws_secretsmanager_secret_version"))
+	handlers.Complete.PushBackNamed(tracer.CompleteHandler())
+
+	req := request.New(
+		aws.Config{},
+		metadata.ClientInfo{ServiceID: secretsmanager.ServiceID, SigningRegion: "us-west-2"},
+		handlers,
+		nil,
+		&request.Operation{Name: "PutSecretValue"},
+		&secretsmanager.PutSecretValueInput{SecretId: aws.String("example"), SecretString: aws.String("hunter2")},
+		nil,
+	)
+	req.Time = start
+	req.RequestID = "c0ffee"
+	req.RetryCount = 2
+	req.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest}
+	req.Error = awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)
+
+	req.Handlers.Validate.Run(req)
+	req.Handlers.Complete.Run(req)
+
+	expected := `{"time":"2021-03-01T00:00:00Z","type":"api_call","resource":"aws_secretsmanager_secret_version","service":"Secrets Manager","operation":"PutSecretValue","region":"us-west-2","duration_ms":1.5,"retry_count":2,"request_id":"c0ffee","status_code":400,"error_code":"ResourceNotFoundException","failed":true,"params":{"SecretId":"example","SecretString":"<sensitive>"}}` + "\n"
+
+	if got := buf.String(); got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestTracerTraceResource(t *testing.T) {
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	buf := &bytes.Buffer{}
+	tracer := New(buf)
+	tracer.now = func() time.Time { return start }
+
+	id := ""
+
+	tracer.TraceResource("aws_sqs_queue", "Create", func() string { return id }, func() bool {
+		id = "https://sqs.us-west-2.amazonaws.com/123456789012/example"
+		start = start.Add(2 * time.Second)
+		return true
+	})
+
+	tracer.TraceResource("aws_sqs_queue", "Read", func() string { return id }, func() bool {
+		return false
+	})
+
+	var records []*Record
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		record := &Record{}
+
+		if err := json.Unmarshal([]byte(line), record); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		records = append(records, record)
+	}
+
+	expected := []*Record{
+		{
+			Time:       time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+			Type:       RecordTypeResource,
+			Resource:   "aws_sqs_queue",
+			ResourceID: id,
+			Operation:  "Create",
+			DurationMs: 2000,
+		},
+		{
+			Time:       time.Date(2021, 3, 1, 0, 0, 2, 0, time.UTC),
+			Type:       RecordTypeResource,
+			Resource:   "aws_sqs_queue",
+			ResourceID: id,
+			Operation:  "Read",
+			Failed:     true,
+		},
+	}
+
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("got %s, expected records to match", buf.String())
+	}
+}
+
+func TestResourceHandlerKeepsExistingResource(t *testing.T) {
+	req := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{Name: "RunInstances"}, nil, nil)
+	req.SetContext(WithResource(aws.BackgroundContext(), "aws_instance"))
+
+	ResourceHandler("aws_ebs_volume").Fn(req)
+
+	if got := ResourceFromContext(req.Context()); got != "aws_instance" {
+		t.Errorf("got resource %s, expected aws_instance", got)
+	}
+}
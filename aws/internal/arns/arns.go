@@ -0,0 +1,209 @@
+//go:generate go run ../generators/arns/main.go arns.json
+
+// Package arns provides typed builders and parsers of the ARNs of AWS service resource types.
+//
+// The functions of each resource type are generated from the specification in arns.json.
+package arns
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+var (
+	accountIDRegexp = regexp.MustCompile(`^(aws|\d{12})$`)
+	partitionRegexp = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+	regionRegexp    = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+// ErrUnknownResourceType is returned by Parse for ARNs which do not match any known resource type.
+var ErrUnknownResourceType = errors.New("unknown ARN resource type")
+
+// Resource is the parsed ARN of a resource type.
+type Resource interface {
+	// Build validates and returns the ARN.
+	Build() (string, error)
+
+	// ResourceFields returns the fields of the ARN resource segment, keyed by snake case name, e.g. vpc_id.
+	ResourceFields() map[string]string
+
+	// ResourceType returns the resource type of the ARN.
+	ResourceType() *ResourceType
+}
+
+// ResourceType describes the ARN format of an AWS service resource type.
+type ResourceType struct {
+	// Service is the ARN service namespace, e.g. ec2.
+	Service string
+
+	// Type is the resource type, e.g. vpc.
+	Type string
+
+	// Regional is whether ARNs of the resource type contain a region.
+	Regional bool
+
+	// Account is whether ARNs of the resource type contain an account ID.
+	Account bool
+
+	// Format is the format of the resource segment, e.g. vpc/{VPCID}.
+	Format string
+
+	format   string
+	prefix   string
+	resource *regexp.Regexp
+	fields   []*resourceField
+	new      func(arn.ARN, []string) Resource
+}
+
+type resourceField struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// String returns the service and resource type, e.g. ec2:vpc.
+func (t *ResourceType) String() string {
+	return t.Service + ":" + t.Type
+}
+
+// Parse parses an ARN of the resource type.
+func (t *ResourceType) Parse(s string) (Resource, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := t.parse(parsedARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s ARN (%s): %w", t, s, err)
+	}
+
+	return t.new(parsedARN, values), nil
+}
+
+// Validate returns an error if s is not a valid ARN of the resource type.
+func (t *ResourceType) Validate(s string) error {
+	_, err := t.Parse(s)
+
+	return err
+}
+
+func (t *ResourceType) parse(parsedARN arn.ARN) ([]string, error) {
+	if parsedARN.Service != t.Service {
+		return nil, fmt.Errorf("expected service %q, got %q", t.Service, parsedARN.Service)
+	}
+
+	if err := t.validateEnvironment(parsedARN.Partition, parsedARN.Region, parsedARN.AccountID); err != nil {
+		return nil, err
+	}
+
+	match := t.resource.FindStringSubmatch(parsedARN.Resource)
+
+	if match == nil {
+		return nil, fmt.Errorf("resource %q does not match format %s", parsedARN.Resource, t.Format)
+	}
+
+	return match[1:], nil
+}
+
+func (t *ResourceType) validateEnvironment(partition, region, accountID string) error {
+	if !partitionRegexp.MatchString(partition) {
+		return fmt.Errorf("invalid partition %q", partition)
+	}
+
+	switch {
+	case t.Regional && !regionRegexp.MatchString(region):
+		return fmt.Errorf("invalid region %q", region)
+	case !t.Regional && region != "":
+		return fmt.Errorf("unexpected region %q", region)
+	}
+
+	switch {
+	case t.Account && !accountIDRegexp.MatchString(accountID):
+		return fmt.Errorf("invalid account ID %q", accountID)
+	case !t.Account && accountID != "":
+		return fmt.Errorf("unexpected account ID %q", accountID)
+	}
+
+	return nil
+}
+
+// build validates the ARN environment and resource segment fields and returns the ARN.
+func (t *ResourceType) build(partition, region, accountID string, values ...string) (string, error) {
+	if err := t.validateEnvironment(partition, region, accountID); err != nil {
+		return "", fmt.Errorf("invalid %s ARN: %w", t, err)
+	}
+
+	args := make([]interface{}, len(t.fields))
+
+	for i, field := range t.fields {
+		if !field.pattern.MatchString(values[i]) {
+			return "", fmt.Errorf("invalid %s ARN: invalid %s %q", t, field.name, values[i])
+		}
+
+		args[i] = values[i]
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   t.Service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf(t.format, args...),
+	}.String(), nil
+}
+
+// Parse parses an ARN of any known resource type.
+// Returns ErrUnknownResourceType if the ARN is valid but its resource type is unknown.
+func Parse(s string) (Resource, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range resourceTypes {
+		if t.Service != parsedARN.Service {
+			continue
+		}
+
+		if values, err := t.parse(parsedARN); err == nil {
+			return t.new(parsedARN, values), nil
+		}
+	}
+
+	return nil, ErrUnknownResourceType
+}
+
+// Validate returns an error if the service and resource segment prefix of the ARN, e.g. ec2 and vpc/,
+// match known resource types, but the ARN is not valid for any of them.
+// ARNs of unknown resource types are not validated.
+func Validate(s string) error {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+
+	for _, t := range resourceTypes {
+		if t.Service != parsedARN.Service || t.prefix == "" || !strings.HasPrefix(parsedARN.Resource, t.prefix) {
+			continue
+		}
+
+		if _, err := t.parse(parsedARN); err != nil {
+			lastErr = fmt.Errorf("invalid %s ARN: %w", t, err)
+			continue
+		}
+
+		return nil
+	}
+
+	return lastErr
+}
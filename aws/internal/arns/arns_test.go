@@ -0,0 +1,298 @@
+package arns
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ARN            string
+		ExpectedType   *ResourceType
+		ExpectedFields map[string]string
+		ExpectedErr    error
+		ExpectError    bool
+	}{
+		{
+			Name:        "invalid ARN",
+			ARN:         "vpc-12345678",
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown service",
+			ARN:         "arn:aws:rds:us-west-2:123456789012:db:example", //lintignore:AWSAT003,AWSAT005
+			ExpectedErr: ErrUnknownResourceType,
+		},
+		{
+			Name:        "unknown resource type",
+			ARN:         "arn:aws:ec2:us-west-2:123456789012:route-table/rtb-12345678", //lintignore:AWSAT003,AWSAT005
+			ExpectedErr: ErrUnknownResourceType,
+		},
+		{
+			Name:           "EC2 VPC",
+			ARN:            "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0", //lintignore:AWSAT003,AWSAT005
+			ExpectedType:   EC2VPCType,
+			ExpectedFields: map[string]string{"vpc_id": "vpc-0123456789abcdef0"},
+		},
+		{
+			Name:           "EC2 snapshot without account",
+			ARN:            "arn:aws-us-gov:ec2:us-gov-west-1::snapshot/snap-12345678", //lintignore:AWSAT003,AWSAT005
+			ExpectedType:   EC2SnapshotType,
+			ExpectedFields: map[string]string{"snapshot_id": "snap-12345678"},
+		},
+		{
+			Name:           "IAM role with path",
+			ARN:            "arn:aws:iam::123456789012:role/service-role/example", //lintignore:AWSAT005
+			ExpectedType:   IAMRoleType,
+			ExpectedFields: map[string]string{"path": "/service-role/", "role_name": "example"},
+		},
+		{
+			Name:           "IAM managed policy",
+			ARN:            "arn:aws:iam::aws:policy/CloudWatchReadOnlyAccess", //lintignore:AWSAT005
+			ExpectedType:   IAMPolicyType,
+			ExpectedFields: map[string]string{"path": "/", "policy_name": "CloudWatchReadOnlyAccess"},
+		},
+		{
+			Name:           "Lambda function",
+			ARN:            "arn:aws:lambda:eu-west-1:123456789012:function:example", //lintignore:AWSAT003,AWSAT005
+			ExpectedType:   LambdaFunctionType,
+			ExpectedFields: map[string]string{"function_name": "example"},
+		},
+		{
+			Name:           "Lambda function qualified",
+			ARN:            "arn:aws:lambda:eu-west-1:123456789012:function:example:$LATEST", //lintignore:AWSAT003,AWSAT005
+			ExpectedType:   LambdaFunctionQualifiedType,
+			ExpectedFields: map[string]string{"function_name": "example", "qualifier": "$LATEST"},
+		},
+		{
+			Name:           "S3 bucket",
+			ARN:            "arn:aws-cn:s3:::example", //lintignore:AWSAT005
+			ExpectedType:   S3BucketType,
+			ExpectedFields: map[string]string{"bucket_name": "example"},
+		},
+		{
+			Name:        "S3 object",
+			ARN:         "arn:aws:s3:::example/key", //lintignore:AWSAT005
+			ExpectedErr: ErrUnknownResourceType,
+		},
+		{
+			Name:           "SSM parameter hierarchy",
+			ARN:            "arn:aws:ssm:us-west-2:123456789012:parameter/path/to/example", //lintignore:AWSAT003,AWSAT005
+			ExpectedType:   SSMParameterType,
+			ExpectedFields: map[string]string{"parameter_name": "path/to/example"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Parse(testCase.ARN)
+
+			if testCase.ExpectError || testCase.ExpectedErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got.ResourceType())
+				}
+
+				if testCase.ExpectedErr != nil && !errors.Is(err, testCase.ExpectedErr) {
+					t.Fatalf("expected error %q, got %q", testCase.ExpectedErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.ResourceType() != testCase.ExpectedType {
+				t.Errorf("got resource type %s, expected %s", got.ResourceType(), testCase.ExpectedType)
+			}
+
+			if !reflect.DeepEqual(got.ResourceFields(), testCase.ExpectedFields) {
+				t.Errorf("got fields %v, expected %v", got.ResourceFields(), testCase.ExpectedFields)
+			}
+
+			built, err := got.Build()
+
+			if err != nil {
+				t.Fatalf("unexpected error building: %s", err)
+			}
+
+			if built != testCase.ARN {
+				t.Errorf("got built ARN %s, expected %s", built, testCase.ARN)
+			}
+		})
+	}
+}
+
+func TestParseTyped(t *testing.T) {
+	got, err := ParseIAMRole("arn:aws:iam::123456789012:role/example") //lintignore:AWSAT005
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := &IAMRole{
+		Partition: "aws",
+		AccountID: "123456789012",
+		Path:      "/",
+		RoleName:  "example",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+
+	invalidARNs := []string{
+		"arn:aws:iam::123456789012:user/example",           //lintignore:AWSAT005
+		"arn:aws:iam:us-west-2:123456789012:role/example",  //lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:role/service-role/",     //lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/example/x", //lintignore:AWSAT005
+	}
+
+	for _, s := range invalidARNs {
+		if _, err := ParseIAMRole(s); err == nil {
+			t.Errorf("expected error parsing %s", s)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Resource    Resource
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name: "EC2 instance",
+			Resource: &EC2Instance{
+				Partition:  "aws-us-gov",
+				Region:     "us-gov-west-1",
+				AccountID:  "123456789012",
+				InstanceID: "i-12345678",
+			},
+			Expected: "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "EC2 image without account",
+			Resource: &EC2Image{
+				Partition: "aws",
+				Region:    "us-west-2",
+				ImageID:   "ami-12345678",
+			},
+			Expected: "arn:aws:ec2:us-west-2::image/ami-12345678", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "IAM user with path",
+			Resource: &IAMUser{
+				Partition: "aws",
+				AccountID: "123456789012",
+				Path:      "/division/",
+				UserName:  "example",
+			},
+			Expected: "arn:aws:iam::123456789012:user/division/example", //lintignore:AWSAT005
+		},
+		{
+			Name: "invalid field",
+			Resource: &EC2VPC{
+				Partition: "aws",
+				Region:    "us-west-2",
+				AccountID: "123456789012",
+				VPCID:     "subnet-12345678",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "field containing delimiter",
+			Resource: &SQSQueue{
+				Partition: "aws",
+				Region:    "us-west-2",
+				AccountID: "123456789012",
+				QueueName: "example:other",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing region",
+			Resource: &EC2VPC{
+				Partition: "aws",
+				AccountID: "123456789012",
+				VPCID:     "vpc-12345678",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid account ID",
+			Resource: &DynamoDBTable{
+				Partition: "aws",
+				Region:    "us-west-2",
+				AccountID: "1234",
+				TableName: "example",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid partition",
+			Resource: &S3Bucket{
+				Partition:  "",
+				BucketName: "example",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := testCase.Resource.Build()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:ec2:us-west-2:123456789012:instance/i-12345678",                     //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-west-2:123456789012:route-table/rtb-12345678",                //lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:eu-west-1:123456789012:function:myCustomFunction:Qualifier",  //lintignore:AWSAT003,AWSAT005
+		"arn:aws:rds:eu-west-1:123456789012:db:mysql-db",                             //lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:::my_corporate_bucket/exampleobject.png",                         //lintignore:AWSAT005
+		"arn:aws:elasticbeanstalk:us-east-1:123456789012:environment/My App/MyEnvir", //lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, s := range validARNs {
+		if err := Validate(s); err != nil {
+			t.Errorf("unexpected error validating %s: %s", s, err)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:ec2:us-west-2:123456789012:instance/vpc-12345678",   //lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam:us-west-2:123456789012:role/example",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:key/not-a-key-id",        //lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:function:a:b:c",       //lintignore:AWSAT003,AWSAT005
+		"arn:aws:secretsmanager:us-west-2:123456789012:secret:a b c", //lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, s := range invalidARNs {
+		if err := Validate(s); err == nil {
+			t.Errorf("expected error validating %s", s)
+		}
+	}
+}
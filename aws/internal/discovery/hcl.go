@@ -0,0 +1,248 @@
+// Package discovery renders Terraform configuration for existing resources
+// from their resource schema and refreshed state.
+package discovery
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceBlock returns a Terraform configuration resource block for the resource data.
+// Only arguments which can be configured and whose values differ from their zero or default value
+// are included. Computed-only and deprecated attributes are omitted.
+func ResourceBlock(resourceType, name string, s map[string]*schema.Schema, d *schema.ResourceData) string {
+	values := make(map[string]interface{}, len(s))
+
+	for k := range s {
+		values[k] = d.Get(k)
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "resource %s %s {\n", quote(resourceType), quote(name))
+	writeBody(&b, 1, s, values)
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// ImportCommand returns a terraform import shell command for the resource.
+func ImportCommand(resourceType, name, id string) string {
+	return fmt.Sprintf("terraform import %s %s\n", shellQuote(resourceType+"."+name), shellQuote(id))
+}
+
+var invalidNameCharsRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// ResourceName returns a valid Terraform resource name derived from an arbitrary string,
+// e.g. an AWS resource name or identifier.
+func ResourceName(s string) string {
+	name := strings.Trim(invalidNameCharsRegexp.ReplaceAllString(strings.ToLower(s), "_"), "_-")
+
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	return name
+}
+
+// NameFromID returns the final segment of an ARN, URL or path style identifier,
+// e.g. the queue name of an SQS queue URL.
+func NameFromID(id string) string {
+	if i := strings.LastIndexAny(id, ":/"); i >= 0 && i < len(id)-1 {
+		return id[i+1:]
+	}
+
+	return id
+}
+
+// UniqueResourceNames tracks resource names so that names derived from different identifiers do not collide.
+type UniqueResourceNames map[string]int
+
+// Name returns ResourceName(s), suffixed with a counter if the name has already been returned.
+func (u UniqueResourceNames) Name(s string) string {
+	name := ResourceName(s)
+	u[name]++
+
+	if n := u[name]; n > 1 {
+		return fmt.Sprintf("%s_%d", name, n)
+	}
+
+	return name
+}
+
+func writeBody(b *strings.Builder, depth int, s map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0, len(s))
+
+	for k, v := range s {
+		if !configurable(v) || omit(v, values[k]) {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	indent := strings.Repeat("  ", depth)
+
+	// Arguments first, then blocks, as terraform fmt would lay them out.
+	for _, k := range keys {
+		if _, ok := s[k].Elem.(*schema.Resource); ok {
+			continue
+		}
+
+		fmt.Fprintf(b, "%s%s = %s\n", indent, k, value(depth, s[k], values[k]))
+	}
+
+	for _, k := range keys {
+		elem, ok := s[k].Elem.(*schema.Resource)
+
+		if !ok {
+			continue
+		}
+
+		for _, item := range items(values[k]) {
+			m, ok := item.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			fmt.Fprintf(b, "\n%s%s {\n", indent, k)
+			writeBody(b, depth+1, elem.Schema, m)
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
+
+// configurable returns true if the attribute can be set in configuration.
+func configurable(s *schema.Schema) bool {
+	return (s.Required || s.Optional) && s.Deprecated == ""
+}
+
+// omit returns true if an attribute value should not be rendered.
+func omit(s *schema.Schema, v interface{}) bool {
+	if s.Required {
+		return v == nil
+	}
+
+	if isZero(v) {
+		return true
+	}
+
+	return s.Default != nil && reflect.DeepEqual(s.Default, v)
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+// items returns the elements of a list or set value.
+func items(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		l := v.List()
+		sort.Slice(l, func(i, j int) bool {
+			return fmt.Sprint(l[i]) < fmt.Sprint(l[j])
+		})
+		return l
+	default:
+		return nil
+	}
+}
+
+// value returns the configuration expression for an attribute value.
+// The attribute schema may be nil, e.g. for map values.
+func value(depth int, s *schema.Schema, v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return quote(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}, *schema.Set:
+		var elemSchema *schema.Schema
+
+		if s != nil {
+			elemSchema, _ = s.Elem.(*schema.Schema)
+		}
+
+		l := items(v)
+		elems := make([]string, 0, len(l))
+
+		for _, e := range l {
+			elems = append(elems, value(depth, elemSchema, e))
+		}
+
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+
+		for k := range v {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		indent := strings.Repeat("  ", depth)
+
+		var b strings.Builder
+
+		b.WriteString("{\n")
+
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%s  %s = %s\n", indent, quote(k), value(depth+1, nil, v[k]))
+		}
+
+		fmt.Fprintf(&b, "%s}", indent)
+
+		return b.String()
+	default:
+		return quote(fmt.Sprint(v))
+	}
+}
+
+// quote returns s as a Terraform configuration string literal,
+// escaping template interpolation and directive sequences.
+func quote(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	q = strings.ReplaceAll(q, "%{", "%%{")
+
+	return q
+}
+
+// shellQuote returns s quoted for POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,165 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceBlock(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"delay_seconds": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+		"retention_seconds": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  345600,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"old_name": {
+			Type:       schema.TypeString,
+			Optional:   true,
+			Deprecated: "use name",
+		},
+		"policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"security_groups": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"name":              "test",
+		"retention_seconds": 60,
+		"old_name":          "deprecated",
+		"policy":            `{"Resource":"${aws:username}"}`,
+		"security_groups":   []interface{}{"sg-2", "sg-1"},
+		"tags": map[string]interface{}{
+			"Name":  "test",
+			"Owner": "team",
+		},
+		"rule": []interface{}{
+			map[string]interface{}{
+				"enabled":  true,
+				"priority": 1,
+			},
+		},
+	})
+
+	expected := `resource "aws_test" "test" {
+  name = "test"
+  policy = "{\"Resource\":\"$${aws:username}\"}"
+  retention_seconds = 60
+  security_groups = ["sg-1", "sg-2"]
+  tags = {
+    "Name" = "test"
+    "Owner" = "team"
+  }
+
+  rule {
+    enabled = true
+    priority = 1
+  }
+}
+`
+
+	if got := ResourceBlock("aws_test", "test", s, d); got != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestImportCommand(t *testing.T) {
+	got := ImportCommand("aws_iam_role", "test", "it's")
+	expected := `terraform import 'aws_iam_role.test' 'it'\''s'` + "\n"
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestNameFromID(t *testing.T) {
+	testCases := []struct {
+		ID       string
+		Expected string
+	}{
+		{
+			ID:       "test",
+			Expected: "test",
+		},
+		{
+			ID:       "arn:aws:sns:us-west-2:123456789012:test-topic",
+			Expected: "test-topic",
+		},
+		{
+			ID:       "https://sqs.us-west-2.amazonaws.com/123456789012/test-queue",
+			Expected: "test-queue",
+		},
+		{
+			ID:       "/aws/lambda/",
+			Expected: "/aws/lambda/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := NameFromID(testCase.ID); got != testCase.Expected {
+			t.Errorf("NameFromID(%q) = %q, expected %q", testCase.ID, got, testCase.Expected)
+		}
+	}
+}
+
+func TestUniqueResourceNames(t *testing.T) {
+	names := make(UniqueResourceNames)
+
+	for _, testCase := range []struct {
+		Input    string
+		Expected string
+	}{
+		{Input: "My.Queue", Expected: "my_queue"},
+		{Input: "my queue", Expected: "my_queue_2"},
+		{Input: "1st", Expected: "r_1st"},
+		{Input: "...", Expected: "r_"},
+	} {
+		if got := names.Name(testCase.Input); got != testCase.Expected {
+			t.Errorf("Name(%q) = %q, expected %q", testCase.Input, got, testCase.Expected)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+// The age file format is specified at https://age-encryption.org/v1.
+// Only the X25519 recipient type is supported.
+const (
+	ageIntro              = "age-encryption.org/v1"
+	ageRecipientPrefix    = "age"
+	ageIdentityPrefix     = "AGE-SECRET-KEY-"
+	ageX25519StanzaType   = "X25519"
+	ageX25519Label        = "age-encryption.org/v1/X25519"
+	ageFileKeySize        = 16
+	agePayloadNonceSize   = 16
+	agePayloadChunkSize   = 64 * 1024
+	agePayloadTagSize     = 16
+	ageStanzaColumnsLimit = 64
+)
+
+var ageBase64 = base64.RawStdEncoding
+
+type ageEncrypter struct {
+	recipient string
+	publicKey []byte
+}
+
+// NewAgeEncrypter returns an Encrypter for the given age X25519 recipient
+// ("age1..."). Values are encrypted in the age file format and can be
+// decrypted with the age command line tool and the recipient's identity.
+func NewAgeEncrypter(recipient string) (Encrypter, error) {
+	publicKey, err := parseAgeKey(ageRecipientPrefix, recipient)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing age recipient (%s): %w", recipient, err)
+	}
+
+	return &ageEncrypter{
+		recipient: recipient,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (e *ageEncrypter) Encrypt(value, description string) (string, string, error) {
+	encrypted, err := ageEncrypt(e.publicKey, []byte(value))
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	return e.recipient, base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+// AgeDecrypt decrypts a base64 encoded value encrypted by an Encrypter returned
+// by NewAgeEncrypter with the given age X25519 identity ("AGE-SECRET-KEY-1...").
+func AgeDecrypt(identity, encryptedValue string) (string, error) {
+	secretKey, err := parseAgeKey(ageIdentityPrefix, identity)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing age identity: %w", err)
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(encryptedValue)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding age encrypted value: %w", err)
+	}
+
+	value, err := ageDecrypt(secretKey, encrypted)
+
+	if err != nil {
+		return "", fmt.Errorf("error decrypting age encrypted value: %w", err)
+	}
+
+	return string(value), nil
+}
+
+// GenerateAgeIdentity returns a new age X25519 identity and its recipient.
+func GenerateAgeIdentity() (string, string, error) {
+	secretKey := make([]byte, curve25519.ScalarSize)
+
+	if _, err := rand.Read(secretKey); err != nil {
+		return "", "", err
+	}
+
+	publicKey, err := curve25519.X25519(secretKey, curve25519.Basepoint)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	identity := strings.ToUpper(bech32Encode(strings.ToLower(ageIdentityPrefix), secretKey))
+
+	return identity, bech32Encode(ageRecipientPrefix, publicKey), nil
+}
+
+func parseAgeKey(prefix, key string) ([]byte, error) {
+	hrp, data, err := bech32Decode(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != strings.ToLower(prefix) {
+		return nil, fmt.Errorf("expected %q prefix, got %q", prefix, hrp)
+	}
+
+	if len(data) != curve25519.PointSize {
+		return nil, fmt.Errorf("expected %d byte key, got %d bytes", curve25519.PointSize, len(data))
+	}
+
+	return data, nil
+}
+
+func ageEncrypt(publicKey, plaintext []byte) ([]byte, error) {
+	fileKey := make([]byte, ageFileKeySize)
+
+	if _, err := rand.Read(fileKey); err != nil {
+		return nil, err
+	}
+
+	ephemeralSecret := make([]byte, curve25519.ScalarSize)
+
+	if _, err := rand.Read(ephemeralSecret); err != nil {
+		return nil, err
+	}
+
+	ephemeralShare, err := curve25519.X25519(ephemeralSecret, curve25519.Basepoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sharedSecret, err := curve25519.X25519(ephemeralSecret, publicKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedFileKey, err := ageAEADSeal(ageX25519WrappingKey(sharedSecret, ephemeralShare, publicKey), make([]byte, chacha20poly1305.NonceSize), fileKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	header := &bytes.Buffer{}
+	fmt.Fprintf(header, "%s\n-> %s %s\n", ageIntro, ageX25519StanzaType, ageBase64.EncodeToString(ephemeralShare))
+
+	body := ageBase64.EncodeToString(wrappedFileKey)
+
+	// The last line of a stanza body is always shorter than the column limit, even if empty.
+	for len(body) >= ageStanzaColumnsLimit {
+		header.WriteString(body[:ageStanzaColumnsLimit] + "\n")
+		body = body[ageStanzaColumnsLimit:]
+	}
+
+	header.WriteString(body + "\n---")
+
+	mac := hmac.New(sha256.New, ageHKDF(fileKey, nil, "header"))
+	mac.Write(header.Bytes())
+
+	encrypted := &bytes.Buffer{}
+	encrypted.Write(header.Bytes())
+	fmt.Fprintf(encrypted, " %s\n", ageBase64.EncodeToString(mac.Sum(nil)))
+
+	payloadNonce := make([]byte, agePayloadNonceSize)
+
+	if _, err := rand.Read(payloadNonce); err != nil {
+		return nil, err
+	}
+
+	encrypted.Write(payloadNonce)
+
+	payloadKey := ageHKDF(fileKey, payloadNonce, "payload")
+
+	for counter := uint64(0); ; counter++ {
+		chunk := plaintext
+
+		if len(chunk) > agePayloadChunkSize {
+			chunk = chunk[:agePayloadChunkSize]
+		}
+
+		plaintext = plaintext[len(chunk):]
+		last := len(plaintext) == 0
+
+		sealed, err := ageAEADSeal(payloadKey, agePayloadChunkNonce(counter, last), chunk)
+
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted.Write(sealed)
+
+		if last {
+			break
+		}
+	}
+
+	return encrypted.Bytes(), nil
+}
+
+func ageDecrypt(secretKey, encrypted []byte) ([]byte, error) {
+	publicKey, err := curve25519.X25519(secretKey, curve25519.Basepoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(encrypted))
+	header := &bytes.Buffer{}
+
+	readLine := func() (string, error) {
+		line, err := reader.ReadString('\n')
+
+		if err != nil {
+			return "", errors.New("truncated header")
+		}
+
+		header.WriteString(line)
+
+		return strings.TrimSuffix(line, "\n"), nil
+	}
+
+	if line, err := readLine(); err != nil {
+		return nil, err
+	} else if line != ageIntro {
+		return nil, fmt.Errorf("unsupported format: %q", line)
+	}
+
+	var fileKey []byte
+	var line string
+
+	for {
+		if line, err = readLine(); err != nil {
+			return nil, err
+		}
+
+		if strings.HasPrefix(line, "---") {
+			break
+		}
+
+		if !strings.HasPrefix(line, "-> ") {
+			return nil, fmt.Errorf("malformed stanza: %q", line)
+		}
+
+		args := strings.Fields(strings.TrimPrefix(line, "-> "))
+		body := &strings.Builder{}
+
+		for {
+			bodyLine, err := readLine()
+
+			if err != nil {
+				return nil, err
+			}
+
+			body.WriteString(bodyLine)
+
+			if len(bodyLine) < ageStanzaColumnsLimit {
+				break
+			}
+		}
+
+		if fileKey != nil || len(args) != 2 || args[0] != ageX25519StanzaType {
+			continue
+		}
+
+		ephemeralShare, err := ageBase64.DecodeString(args[1])
+
+		if err != nil {
+			return nil, fmt.Errorf("malformed X25519 stanza: %w", err)
+		}
+
+		wrappedFileKey, err := ageBase64.DecodeString(body.String())
+
+		if err != nil {
+			return nil, fmt.Errorf("malformed X25519 stanza: %w", err)
+		}
+
+		sharedSecret, err := curve25519.X25519(secretKey, ephemeralShare)
+
+		if err != nil {
+			return nil, fmt.Errorf("malformed X25519 stanza: %w", err)
+		}
+
+		// A stanza for another recipient fails authentication.
+		if key, err := ageAEADOpen(ageX25519WrappingKey(sharedSecret, ephemeralShare, publicKey), make([]byte, chacha20poly1305.NonceSize), wrappedFileKey); err == nil {
+			fileKey = key
+		}
+	}
+
+	if fileKey == nil {
+		return nil, errors.New("no X25519 stanza matches identity")
+	}
+
+	expectedMAC, err := ageBase64.DecodeString(strings.TrimPrefix(line, "--- "))
+
+	if err != nil {
+		return nil, fmt.Errorf("malformed header MAC: %w", err)
+	}
+
+	// The MAC covers the header up to and including "---".
+	macInput := header.Bytes()[:header.Len()-len(line)-1+len("---")]
+	mac := hmac.New(sha256.New, ageHKDF(fileKey, nil, "header"))
+	mac.Write(macInput)
+
+	if !hmac.Equal(mac.Sum(nil), expectedMAC) {
+		return nil, errors.New("header MAC mismatch")
+	}
+
+	payloadNonce := make([]byte, agePayloadNonceSize)
+
+	if _, err := io.ReadFull(reader, payloadNonce); err != nil {
+		return nil, errors.New("truncated payload")
+	}
+
+	payloadKey := ageHKDF(fileKey, payloadNonce, "payload")
+	payload, err := ioutil.ReadAll(reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var plaintext []byte
+	sealedChunkSize := agePayloadChunkSize + agePayloadTagSize
+
+	for counter := uint64(0); ; counter++ {
+		chunk := payload
+
+		if len(chunk) > sealedChunkSize {
+			chunk = chunk[:sealedChunkSize]
+		}
+
+		payload = payload[len(chunk):]
+		last := len(payload) == 0
+
+		opened, err := ageAEADOpen(payloadKey, agePayloadChunkNonce(counter, last), chunk)
+
+		if err != nil {
+			return nil, fmt.Errorf("payload chunk %d: %w", counter, err)
+		}
+
+		plaintext = append(plaintext, opened...)
+
+		if last {
+			return plaintext, nil
+		}
+	}
+}
+
+func ageX25519WrappingKey(sharedSecret, ephemeralShare, publicKey []byte) []byte {
+	salt := make([]byte, 0, len(ephemeralShare)+len(publicKey))
+	salt = append(salt, ephemeralShare...)
+	salt = append(salt, publicKey...)
+
+	return ageHKDF(sharedSecret, salt, ageX25519Label)
+}
+
+func ageHKDF(secret, salt []byte, info string) []byte {
+	key := make([]byte, chacha20poly1305.KeySize)
+
+	// Reading a single key from HKDF-SHA-256 cannot fail.
+	_, _ = io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(info)), key)
+
+	return key
+}
+
+func agePayloadChunkNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+
+	for i := chacha20poly1305.NonceSize - 2; i >= 0 && counter > 0; i-- {
+		nonce[i] = byte(counter)
+		counter >>= 8
+	}
+
+	if last {
+		nonce[chacha20poly1305.NonceSize-1] = 1
+	}
+
+	return nonce
+}
+
+func ageAEADSeal(key, nonce, plaintext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, nonce, plaintext, nil), nil
+}
+
+func ageAEADOpen(key, nonce, ciphertext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
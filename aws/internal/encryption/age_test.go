@@ -0,0 +1,143 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// Test vector from the age specification test kit.
+const (
+	testAgeIdentity  = "AGE-SECRET-KEY-1GFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPYYSJZGFPQ4EGAEX"
+	testAgeRecipient = "age1zvkyg2lqzraa2lnjvqej32nkuu0ues2s82hzrye869xeexvn73equnujwj"
+)
+
+func TestAgeEncrypter(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Value string
+	}{
+		{Name: "empty", Value: ""},
+		{Name: "secret", Value: "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"},
+		{Name: "single chunk", Value: strings.Repeat("a", agePayloadChunkSize)},
+		{Name: "multiple chunks", Value: strings.Repeat("b", 2*agePayloadChunkSize+1)},
+	}
+
+	encrypter, err := NewAgeEncrypter(testAgeRecipient)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			fingerprint, encrypted, err := encrypter.Encrypt(testCase.Value, "test value")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if fingerprint != testAgeRecipient {
+				t.Errorf("got fingerprint %s, expected %s", fingerprint, testAgeRecipient)
+			}
+
+			decrypted, err := AgeDecrypt(testAgeIdentity, encrypted)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if decrypted != testCase.Value {
+				t.Errorf("got decrypted value of length %d, expected %d", len(decrypted), len(testCase.Value))
+			}
+		})
+	}
+}
+
+func TestAgeDecryptErrors(t *testing.T) {
+	encrypter, err := NewAgeEncrypter(testAgeRecipient)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, encrypted, err := encrypter.Encrypt("secret", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	otherIdentity, _, err := GenerateAgeIdentity()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := AgeDecrypt(otherIdentity, encrypted); err == nil || !strings.Contains(err.Error(), "no X25519 stanza matches identity") {
+		t.Errorf("expected identity mismatch error, got: %v", err)
+	}
+
+	raw, _ := base64.StdEncoding.DecodeString(encrypted)
+
+	// Flip a bit in the last byte of the payload's authentication tag.
+	tampered := append([]byte{}, raw...)
+	tampered[len(tampered)-1] ^= 1
+
+	if _, err := AgeDecrypt(testAgeIdentity, base64.StdEncoding.EncodeToString(tampered)); err == nil {
+		t.Error("expected error decrypting tampered payload")
+	}
+
+	// Replace the header MAC.
+	tampered = append([]byte{}, raw...)
+	mac := strings.Index(string(tampered), "\n--- ") + len("\n--- ")
+	copy(tampered[mac:], ageBase64.EncodeToString(make([]byte, 32)))
+
+	if _, err := AgeDecrypt(testAgeIdentity, base64.StdEncoding.EncodeToString(tampered)); err == nil || !strings.Contains(err.Error(), "header MAC mismatch") {
+		t.Errorf("expected header MAC error, got: %v", err)
+	}
+}
+
+func TestNewAgeEncrypterInvalidRecipient(t *testing.T) {
+	testCases := []string{
+		"",
+		"age1",
+		testAgeIdentity,
+		strings.ToUpper(testAgeRecipient[:10]) + testAgeRecipient[10:],
+		testAgeRecipient[:len(testAgeRecipient)-1] + "q",
+		bech32Encode(ageRecipientPrefix, make([]byte, 31)),
+	}
+
+	for _, recipient := range testCases {
+		if _, err := NewAgeEncrypter(recipient); err == nil {
+			t.Errorf("expected error for recipient %q", recipient)
+		}
+	}
+}
+
+func TestGenerateAgeIdentity(t *testing.T) {
+	identity, recipient, err := GenerateAgeIdentity()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(identity, "AGE-SECRET-KEY-1") {
+		t.Errorf("got identity with unexpected prefix: %s", identity[:16])
+	}
+
+	encrypter, err := NewAgeEncrypter(recipient)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, encrypted, err := encrypter.Encrypt("secret", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if decrypted, err := AgeDecrypt(identity, encrypted); err != nil || decrypted != "secret" {
+		t.Errorf("got decrypted value %q (%v), expected secret", decrypted, err)
+	}
+}
@@ -8,6 +8,34 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/vault/helper/pgpkeys"
 )
 
+// Encrypter encrypts sensitive values so they are not stored in state as plaintext.
+type Encrypter interface {
+	// Encrypt returns the fingerprint of the key the value was encrypted with and
+	// the base64 encoded encrypted value. Description should be set such that
+	// errors return a meaningful user-facing response.
+	Encrypt(value, description string) (string, string, error)
+}
+
+type pgpEncrypter struct {
+	encryptionKey string
+}
+
+// NewPGPEncrypter returns an Encrypter for the PGP key specified as the pgpKey
+// parameter, see RetrieveGPGKey.
+func NewPGPEncrypter(pgpKey string) (Encrypter, error) {
+	encryptionKey, err := RetrieveGPGKey(pgpKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pgpEncrypter{encryptionKey: encryptionKey}, nil
+}
+
+func (e *pgpEncrypter) Encrypt(value, description string) (string, string, error) {
+	return EncryptValue(e.encryptionKey, value, description)
+}
+
 // RetrieveGPGKey returns the PGP key specified as the pgpKey parameter, or queries
 // the public key from the keybase service if the parameter is a keybase username
 // prefixed with the phrase "keybase:"
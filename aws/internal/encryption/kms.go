@@ -0,0 +1,134 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// A KMS envelope encrypted value is the length of the encrypted data key as a
+// big-endian uint16, the encrypted data key, the AES-GCM nonce and the AES-GCM
+// sealed value.
+const kmsEnvelopeDataKeyLengthSize = 2
+
+type kmsEncrypter struct {
+	conn  kmsiface.KMSAPI
+	keyID string
+}
+
+// NewKMSEncrypter returns an Encrypter which envelope encrypts values with a
+// data key generated under the given KMS key ID, ARN, alias name or alias ARN.
+// Values can be decrypted by anyone allowed to call kms:Decrypt on the key, see
+// KMSEnvelopeDecrypt.
+func NewKMSEncrypter(conn kmsiface.KMSAPI, keyID string) Encrypter {
+	return &kmsEncrypter{
+		conn:  conn,
+		keyID: keyID,
+	}
+}
+
+func (e *kmsEncrypter) Encrypt(value, description string) (string, string, error) {
+	output, err := e.conn.GenerateDataKey(&kms.GenerateDataKeyInput{
+		KeyId:   aws.String(e.keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error generating KMS data key (%s) to encrypt %s: %w", e.keyID, description, err)
+	}
+
+	if output == nil || len(output.Plaintext) == 0 || len(output.CiphertextBlob) == 0 {
+		return "", "", fmt.Errorf("Error generating KMS data key (%s) to encrypt %s: empty response", e.keyID, description)
+	}
+
+	if len(output.CiphertextBlob) > 1<<16-1 {
+		return "", "", fmt.Errorf("Error encrypting %s: KMS data key (%s) too large", description, e.keyID)
+	}
+
+	gcm, err := kmsEnvelopeCipher(output.Plaintext)
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	envelope := make([]byte, kmsEnvelopeDataKeyLengthSize, kmsEnvelopeDataKeyLengthSize+len(output.CiphertextBlob)+len(nonce)+len(value)+gcm.Overhead())
+	binary.BigEndian.PutUint16(envelope, uint16(len(output.CiphertextBlob)))
+	envelope = append(envelope, output.CiphertextBlob...)
+	envelope = append(envelope, nonce...)
+	envelope = gcm.Seal(envelope, nonce, []byte(value), nil)
+
+	return aws.StringValue(output.KeyId), base64.StdEncoding.EncodeToString(envelope), nil
+}
+
+// KMSEnvelopeDecrypt decrypts a base64 encoded value encrypted by an Encrypter
+// returned by NewKMSEncrypter.
+func KMSEnvelopeDecrypt(conn kmsiface.KMSAPI, encryptedValue string) (string, error) {
+	envelope, err := base64.StdEncoding.DecodeString(encryptedValue)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding KMS envelope: %w", err)
+	}
+
+	if len(envelope) < kmsEnvelopeDataKeyLengthSize {
+		return "", errors.New("error decoding KMS envelope: too short")
+	}
+
+	dataKeyLength := int(binary.BigEndian.Uint16(envelope))
+	envelope = envelope[kmsEnvelopeDataKeyLengthSize:]
+
+	if len(envelope) < dataKeyLength {
+		return "", errors.New("error decoding KMS envelope: too short")
+	}
+
+	output, err := conn.Decrypt(&kms.DecryptInput{
+		CiphertextBlob: envelope[:dataKeyLength],
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error decrypting KMS data key: %w", err)
+	}
+
+	gcm, err := kmsEnvelopeCipher(output.Plaintext)
+
+	if err != nil {
+		return "", err
+	}
+
+	envelope = envelope[dataKeyLength:]
+
+	if len(envelope) < gcm.NonceSize() {
+		return "", errors.New("error decoding KMS envelope: too short")
+	}
+
+	value, err := gcm.Open(nil, envelope[:gcm.NonceSize()], envelope[gcm.NonceSize():], nil)
+
+	if err != nil {
+		return "", fmt.Errorf("error decrypting KMS envelope: %w", err)
+	}
+
+	return string(value), nil
+}
+
+func kmsEnvelopeCipher(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,99 @@
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+const testKMSKeyARN = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+// testKMSConn generates a fixed data key whose ciphertext blob is the data key reversed.
+type testKMSConn struct {
+	kmsiface.KMSAPI
+	dataKey []byte
+}
+
+func (c *testKMSConn) GenerateDataKey(input *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+	if aws.StringValue(input.KeySpec) != kms.DataKeySpecAes256 {
+		return nil, errors.New("unexpected key spec")
+	}
+
+	return &kms.GenerateDataKeyOutput{
+		CiphertextBlob: testReverseBytes(c.dataKey),
+		KeyId:          aws.String(testKMSKeyARN),
+		Plaintext:      c.dataKey,
+	}, nil
+}
+
+func (c *testKMSConn) Decrypt(input *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	if !bytes.Equal(input.CiphertextBlob, testReverseBytes(c.dataKey)) {
+		return nil, errors.New("InvalidCiphertextException")
+	}
+
+	return &kms.DecryptOutput{
+		KeyId:     aws.String(testKMSKeyARN),
+		Plaintext: c.dataKey,
+	}, nil
+}
+
+func testReverseBytes(b []byte) []byte {
+	reversed := make([]byte, len(b))
+
+	for i := range b {
+		reversed[len(b)-1-i] = b[i]
+	}
+
+	return reversed
+}
+
+func TestKMSEncrypter(t *testing.T) {
+	conn := &testKMSConn{dataKey: bytes.Repeat([]byte{0x42, 0x24}, 16)}
+	encrypter := NewKMSEncrypter(conn, "alias/terraform")
+
+	fingerprint, encrypted, err := encrypter.Encrypt("wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if fingerprint != testKMSKeyARN {
+		t.Errorf("got fingerprint %s, expected %s", fingerprint, testKMSKeyARN)
+	}
+
+	decrypted, err := KMSEnvelopeDecrypt(conn, encrypted)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"; decrypted != expected {
+		t.Errorf("got decrypted value %s, expected %s", decrypted, expected)
+	}
+
+	_, other, err := encrypter.Encrypt("wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", "test value")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if other == encrypted {
+		t.Error("expected encrypted values to use different nonces")
+	}
+
+	otherConn := &testKMSConn{dataKey: bytes.Repeat([]byte{0x24}, 32)}
+
+	if _, err := KMSEnvelopeDecrypt(otherConn, encrypted); err == nil {
+		t.Error("expected error decrypting with another data key")
+	}
+
+	for _, value := range []string{"", "AA==", "ACAA", "not base64"} {
+		if _, err := KMSEnvelopeDecrypt(conn, value); err == nil {
+			t.Errorf("expected error decrypting %q", value)
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// DynamoDBTable is a fake DynamoDB table. Tables and their indexes are active as soon as they are created.
+// Key schema and index definitions are held as the JSON of the request that created them.
+type DynamoDBTable struct {
+	AttributeDefinitions       json.RawMessage
+	BillingMode                string
+	CreationDateTime           time.Time
+	GlobalSecondaryIndexes     []map[string]interface{}
+	KeySchema                  json.RawMessage
+	LocalSecondaryIndexes      json.RawMessage
+	Name                       string
+	PointInTimeRecoveryEnabled bool
+	ReadCapacityUnits          int64
+	StreamEnabled              bool
+	StreamViewType             string
+	TableID                    string
+	Tags                       map[string]string
+	TimeToLiveAttributeName    string
+	TimeToLiveEnabled          bool
+	WriteCapacityUnits         int64
+}
+
+func (t *DynamoDBTable) arn() string {
+	return arn("dynamodb", Region, "table/"+t.Name)
+}
+
+func (t *DynamoDBTable) description() map[string]interface{} {
+	description := map[string]interface{}{
+		"AttributeDefinitions": t.AttributeDefinitions,
+		"BillingModeSummary": map[string]interface{}{
+			"BillingMode": t.BillingMode,
+		},
+		"CreationDateTime": epochSeconds(t.CreationDateTime),
+		"ItemCount":        0,
+		"KeySchema":        t.KeySchema,
+		"ProvisionedThroughput": map[string]interface{}{
+			"NumberOfDecreasesToday": 0,
+			"ReadCapacityUnits":      t.ReadCapacityUnits,
+			"WriteCapacityUnits":     t.WriteCapacityUnits,
+		},
+		"TableArn":       t.arn(),
+		"TableId":        t.TableID,
+		"TableName":      t.Name,
+		"TableSizeBytes": 0,
+		"TableStatus":    "ACTIVE",
+	}
+
+	if len(t.GlobalSecondaryIndexes) > 0 {
+		indexes := make([]map[string]interface{}, 0, len(t.GlobalSecondaryIndexes))
+
+		for _, gsi := range t.GlobalSecondaryIndexes {
+			index := make(map[string]interface{}, len(gsi)+2)
+
+			for k, v := range gsi {
+				index[k] = v
+			}
+
+			index["IndexArn"] = t.arn() + "/index/" + gsi["IndexName"].(string)
+			index["IndexStatus"] = "ACTIVE"
+			indexes = append(indexes, index)
+		}
+
+		description["GlobalSecondaryIndexes"] = indexes
+	}
+
+	if len(t.LocalSecondaryIndexes) > 0 {
+		description["LocalSecondaryIndexes"] = t.LocalSecondaryIndexes
+	}
+
+	if t.StreamEnabled {
+		description["LatestStreamArn"] = t.arn() + "/stream/" + t.CreationDateTime.UTC().Format("2006-01-02T15:04:05.000")
+		description["LatestStreamLabel"] = t.CreationDateTime.UTC().Format("2006-01-02T15:04:05.000")
+		description["StreamSpecification"] = map[string]interface{}{
+			"StreamEnabled":  true,
+			"StreamViewType": t.StreamViewType,
+		}
+	}
+
+	return description
+}
+
+type dynamodbProvisionedThroughput struct {
+	ReadCapacityUnits  int64
+	WriteCapacityUnits int64
+}
+
+type dynamodbStreamSpecification struct {
+	StreamEnabled  bool
+	StreamViewType string
+}
+
+func (s *Server) dynamodbTable(name string) (*DynamoDBTable, *apiError) {
+	table, ok := s.DynamoDBTables[name]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "ResourceNotFoundException", "Requested resource not found: Table: %s not found", name)
+	}
+
+	return table, nil
+}
+
+func (s *Server) dynamodbTableByArn(resourceArn string) (*DynamoDBTable, *apiError) {
+	for _, table := range s.DynamoDBTables {
+		if table.arn() == resourceArn {
+			return table, nil
+		}
+	}
+
+	return nil, newError(http.StatusBadRequest, "ResourceNotFoundException", "Requested resource not found: ResourceArn: %s not found", resourceArn)
+}
+
+func (s *Server) dynamodb(action string, body []byte) (interface{}, *apiError) {
+	switch action {
+	case "CreateTable":
+		var input struct {
+			AttributeDefinitions   json.RawMessage
+			BillingMode            string
+			GlobalSecondaryIndexes []map[string]interface{}
+			KeySchema              json.RawMessage
+			LocalSecondaryIndexes  json.RawMessage
+			ProvisionedThroughput  *dynamodbProvisionedThroughput
+			StreamSpecification    *dynamodbStreamSpecification
+			TableName              string
+			Tags                   []Tag
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		if _, ok := s.DynamoDBTables[input.TableName]; ok {
+			return nil, newError(http.StatusBadRequest, "ResourceInUseException", "Table already exists: %s", input.TableName)
+		}
+
+		table := &DynamoDBTable{
+			AttributeDefinitions:   input.AttributeDefinitions,
+			BillingMode:            "PROVISIONED",
+			CreationDateTime:       time.Now(),
+			GlobalSecondaryIndexes: input.GlobalSecondaryIndexes,
+			KeySchema:              input.KeySchema,
+			LocalSecondaryIndexes:  input.LocalSecondaryIndexes,
+			Name:                   input.TableName,
+			TableID:                s.nextID(""),
+			Tags:                   make(map[string]string),
+		}
+
+		if input.BillingMode != "" {
+			table.BillingMode = input.BillingMode
+		}
+
+		if input.ProvisionedThroughput != nil {
+			table.ReadCapacityUnits = input.ProvisionedThroughput.ReadCapacityUnits
+			table.WriteCapacityUnits = input.ProvisionedThroughput.WriteCapacityUnits
+		}
+
+		if input.StreamSpecification != nil {
+			table.StreamEnabled = input.StreamSpecification.StreamEnabled
+			table.StreamViewType = input.StreamSpecification.StreamViewType
+		}
+
+		for _, tag := range input.Tags {
+			table.Tags[tag.Key] = tag.Value
+		}
+
+		s.DynamoDBTables[table.Name] = table
+
+		return map[string]interface{}{"TableDescription": table.description()}, nil
+
+	case "DeleteTable":
+		var input struct {
+			TableName string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		delete(s.DynamoDBTables, table.Name)
+
+		description := table.description()
+		description["TableStatus"] = "DELETING"
+
+		return map[string]interface{}{"TableDescription": description}, nil
+
+	case "DescribeContinuousBackups":
+		var input struct {
+			TableName string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "TableNotFoundException", "Table not found: %s", input.TableName)
+		}
+
+		return map[string]interface{}{"ContinuousBackupsDescription": dynamodbContinuousBackupsDescription(table)}, nil
+
+	case "DescribeTable":
+		var input struct {
+			TableName string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{"Table": table.description()}, nil
+
+	case "DescribeTimeToLive":
+		var input struct {
+			TableName string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]interface{}{"TimeToLiveDescription": dynamodbTimeToLiveDescription(table)}, nil
+
+	case "ListTagsOfResource":
+		var input struct {
+			ResourceArn string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTableByArn(input.ResourceArn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &struct {
+			Tags []Tag
+		}{tagList(table.Tags)}, nil
+
+	case "TagResource":
+		var input struct {
+			ResourceArn string
+			Tags        []Tag
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTableByArn(input.ResourceArn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range input.Tags {
+			table.Tags[tag.Key] = tag.Value
+		}
+
+		return nil, nil
+
+	case "UntagResource":
+		var input struct {
+			ResourceArn string
+			TagKeys     []string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTableByArn(input.ResourceArn)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range input.TagKeys {
+			delete(table.Tags, k)
+		}
+
+		return nil, nil
+
+	case "UpdateContinuousBackups":
+		var input struct {
+			PointInTimeRecoverySpecification struct {
+				PointInTimeRecoveryEnabled bool
+			}
+			TableName string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "TableNotFoundException", "Table not found: %s", input.TableName)
+		}
+
+		table.PointInTimeRecoveryEnabled = input.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled
+
+		return map[string]interface{}{"ContinuousBackupsDescription": dynamodbContinuousBackupsDescription(table)}, nil
+
+	case "UpdateTable":
+		var input struct {
+			AttributeDefinitions        json.RawMessage
+			BillingMode                 string
+			GlobalSecondaryIndexUpdates []struct {
+				Create map[string]interface{}
+				Delete *struct {
+					IndexName string
+				}
+				Update *struct {
+					IndexName             string
+					ProvisionedThroughput map[string]interface{}
+				}
+			}
+			ProvisionedThroughput *dynamodbProvisionedThroughput
+			StreamSpecification   *dynamodbStreamSpecification
+			TableName             string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(input.AttributeDefinitions) > 0 {
+			table.AttributeDefinitions = input.AttributeDefinitions
+		}
+
+		if input.BillingMode != "" {
+			table.BillingMode = input.BillingMode
+		}
+
+		if input.ProvisionedThroughput != nil {
+			table.ReadCapacityUnits = input.ProvisionedThroughput.ReadCapacityUnits
+			table.WriteCapacityUnits = input.ProvisionedThroughput.WriteCapacityUnits
+		}
+
+		if input.StreamSpecification != nil {
+			table.StreamEnabled = input.StreamSpecification.StreamEnabled
+			table.StreamViewType = input.StreamSpecification.StreamViewType
+		}
+
+		for _, update := range input.GlobalSecondaryIndexUpdates {
+			switch {
+			case update.Create != nil:
+				table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, update.Create)
+			case update.Delete != nil:
+				for i, gsi := range table.GlobalSecondaryIndexes {
+					if gsi["IndexName"] == update.Delete.IndexName {
+						table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes[:i], table.GlobalSecondaryIndexes[i+1:]...)
+						break
+					}
+				}
+			case update.Update != nil:
+				for _, gsi := range table.GlobalSecondaryIndexes {
+					if gsi["IndexName"] == update.Update.IndexName {
+						gsi["ProvisionedThroughput"] = update.Update.ProvisionedThroughput
+					}
+				}
+			}
+		}
+
+		return map[string]interface{}{"TableDescription": table.description()}, nil
+
+	case "UpdateTimeToLive":
+		var input struct {
+			TableName               string
+			TimeToLiveSpecification struct {
+				AttributeName string
+				Enabled       bool
+			}
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		table, err := s.dynamodbTable(input.TableName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		table.TimeToLiveAttributeName = input.TimeToLiveSpecification.AttributeName
+		table.TimeToLiveEnabled = input.TimeToLiveSpecification.Enabled
+
+		return map[string]interface{}{"TimeToLiveSpecification": input.TimeToLiveSpecification}, nil
+
+	default:
+		return nil, errInvalidAction("dynamodb", action)
+	}
+}
+
+func dynamodbContinuousBackupsDescription(table *DynamoDBTable) map[string]interface{} {
+	status := "DISABLED"
+
+	if table.PointInTimeRecoveryEnabled {
+		status = "ENABLED"
+	}
+
+	return map[string]interface{}{
+		"ContinuousBackupsStatus": "ENABLED",
+		"PointInTimeRecoveryDescription": map[string]interface{}{
+			"PointInTimeRecoveryStatus": status,
+		},
+	}
+}
+
+func dynamodbTimeToLiveDescription(table *DynamoDBTable) map[string]interface{} {
+	if !table.TimeToLiveEnabled {
+		return map[string]interface{}{
+			"TimeToLiveStatus": "DISABLED",
+		}
+	}
+
+	return map[string]interface{}{
+		"AttributeName":    table.TimeToLiveAttributeName,
+		"TimeToLiveStatus": "ENABLED",
+	}
+}
@@ -0,0 +1,435 @@
+// Package fakeaws provides an in-memory fake of a subset of AWS service APIs for unit testing
+// resource CRUD functions without network access or AWS credentials.
+//
+// The fake supports the query protocol services SQS, SNS and IAM and the JSON protocol
+// services SSM and the DynamoDB control plane. Only the operations needed by the corresponding
+// provider resources are implemented; other operations return an InvalidAction error.
+// State is held in memory and can be modified directly by tests to simulate drift.
+//
+// This package is for testing only.
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// AccountID is the AWS account ID of all resources in the fake.
+	AccountID = "123456789012"
+
+	// Partition is the AWS partition of all resources in the fake.
+	Partition = "aws"
+
+	// Region is the AWS region of all regional resources in the fake.
+	Region = "us-west-2"
+)
+
+// Services lists the endpoint keys of the provider endpoints configuration block served by the fake.
+var Services = []string{"dynamodb", "iam", "sns", "sqs", "ssm", "sts"}
+
+// Server is an in-memory fake AWS endpoint.
+// All fields holding service state are protected by the embedded Mutex,
+// which tests must hold when accessing them directly.
+type Server struct {
+	sync.Mutex
+
+	DynamoDBTables  map[string]*DynamoDBTable
+	IAMRoles        map[string]*IAMRole
+	SNSTopics       map[string]*SNSTopic
+	SQSQueues       map[string]*SQSQueue
+	SSMParameters   map[string]*SSMParameter
+	UnhandledAction []string
+
+	server   *httptest.Server
+	sequence int
+}
+
+// New starts and returns a Server. Close must be called to stop it.
+func New() *Server {
+	s := &Server{
+		DynamoDBTables: make(map[string]*DynamoDBTable),
+		IAMRoles:       make(map[string]*IAMRole),
+		SNSTopics:      make(map[string]*SNSTopic),
+		SQSQueues:      make(map[string]*SQSQueue),
+		SSMParameters:  make(map[string]*SSMParameter),
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Close stops the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Endpoints returns the server URL for each service, keyed as in the provider endpoints configuration block.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(Services))
+
+	for _, service := range Services {
+		endpoints[service] = s.server.URL
+	}
+
+	return endpoints
+}
+
+// nextID returns a unique identifier for a new resource. The caller must hold the lock.
+func (s *Server) nextID(prefix string) string {
+	s.sequence++
+
+	return fmt.Sprintf("%s%017d", prefix, s.sequence)
+}
+
+// arn returns the ARN of a resource in the fake account.
+func arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", Partition, service, region, AccountID, resource)
+}
+
+// credentialScopeRegexp extracts the signing service name from the SigV4 Authorization header.
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[0-9]+/[^/]+/([^/]+)/aws4_request`)
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		s.serveJSON(w, target, body)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	service := ""
+
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		service = m[1]
+	}
+
+	s.serveQuery(w, service, query(form))
+}
+
+// Errors
+
+// apiError is an AWS API error returned by an operation handler.
+type apiError struct {
+	code       string
+	message    string
+	statusCode int
+}
+
+func (err *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", err.code, err.message)
+}
+
+func newError(statusCode int, code, format string, a ...interface{}) *apiError {
+	return &apiError{
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+		statusCode: statusCode,
+	}
+}
+
+func errInvalidAction(service, action string) *apiError {
+	return newError(http.StatusBadRequest, "InvalidAction", "The action %s is not valid for this fake %s endpoint", action, service)
+}
+
+// Query protocol
+
+// query wraps the form parameters of a query protocol request.
+type query url.Values
+
+func (q query) get(name string) string {
+	return url.Values(q).Get(name)
+}
+
+// list returns the values of a list parameter, e.g. prefix.member.1, prefix.member.2.
+func (q query) list(prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		key := fmt.Sprintf("%s.%d", prefix, i)
+
+		if _, ok := q[key]; !ok {
+			return values
+		}
+
+		values = append(values, q.get(key))
+	}
+}
+
+// mapEntries returns the key-value pairs of a map or list of key-value structures,
+// e.g. prefix.1.keyName and prefix.1.valueName.
+func (q query) mapEntries(prefix, keyName, valueName string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		key := fmt.Sprintf("%s.%d.%s", prefix, i, keyName)
+
+		if _, ok := q[key]; !ok {
+			return m
+		}
+
+		m[q.get(key)] = q.get(fmt.Sprintf("%s.%d.%s", prefix, i, valueName))
+	}
+}
+
+func (s *Server) serveQuery(w http.ResponseWriter, service string, q query) {
+	action := q.get("Action")
+
+	var result interface{}
+	var err *apiError
+
+	s.Lock()
+
+	switch service {
+	case "iam":
+		result, err = s.iam(action, q)
+	case "sns":
+		result, err = s.sns(action, q)
+	case "sqs":
+		result, err = s.sqs(action, q)
+	case "sts":
+		result, err = s.sts(action, q)
+	default:
+		err = errInvalidAction(service, action)
+	}
+
+	if err != nil && err.code == "InvalidAction" {
+		s.UnhandledAction = append(s.UnhandledAction, service+":"+action)
+	}
+
+	s.Unlock()
+
+	w.Header().Set("Content-Type", "text/xml")
+
+	if err != nil {
+		w.WriteHeader(err.statusCode)
+		writeXML(w, &queryErrorResponse{
+			Error: queryError{
+				Code:    err.code,
+				Message: err.message,
+				Type:    "Sender",
+			},
+			RequestID: requestID(),
+		})
+		return
+	}
+
+	writeXML(w, &queryResponse{
+		XMLName:   xml.Name{Local: action + "Response"},
+		Result:    queryResult{name: action + "Result", value: result},
+		RequestID: requestID(),
+	})
+}
+
+type queryResponse struct {
+	XMLName   xml.Name
+	Result    queryResult
+	RequestID string `xml:"ResponseMetadata>RequestId"`
+}
+
+// queryResult is the result element of a query protocol response,
+// whose child elements are the fields of the operation's result value.
+type queryResult struct {
+	name  string
+	value interface{}
+}
+
+func (r queryResult) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := xml.StartElement{Name: xml.Name{Local: r.name}}
+
+	if r.value == nil {
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+
+		return e.EncodeToken(start.End())
+	}
+
+	return e.EncodeElement(r.value, start)
+}
+
+type queryErrorResponse struct {
+	XMLName   xml.Name   `xml:"ErrorResponse"`
+	Error     queryError `xml:"Error"`
+	RequestID string     `xml:"RequestId"`
+}
+
+type queryError struct {
+	Type    string `xml:"Type"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	b, err := xml.Marshal(v)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(append([]byte(xml.Header), b...)) //nolint:errcheck
+}
+
+// JSON protocol
+
+func (s *Server) serveJSON(w http.ResponseWriter, target string, body []byte) {
+	var result interface{}
+	var err *apiError
+
+	parts := strings.SplitN(target, ".", 2)
+
+	if len(parts) != 2 {
+		http.Error(w, fmt.Sprintf("invalid X-Amz-Target header: %s", target), http.StatusBadRequest)
+		return
+	}
+
+	prefix, action := parts[0], parts[1]
+
+	s.Lock()
+
+	switch prefix {
+	case "AmazonSSM":
+		result, err = s.ssm(action, body)
+	case "DynamoDB_20120810":
+		result, err = s.dynamodb(action, body)
+	default:
+		err = errInvalidAction(prefix, action)
+	}
+
+	if err != nil && err.code == "InvalidAction" {
+		s.UnhandledAction = append(s.UnhandledAction, prefix+":"+action)
+	}
+
+	s.Unlock()
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-Requestid", requestID())
+
+	if err != nil {
+		w.WriteHeader(err.statusCode)
+		writeJSON(w, map[string]string{
+			"__type":  err.code,
+			"message": err.message,
+		})
+		return
+	}
+
+	if result == nil {
+		result = struct{}{}
+	}
+
+	writeJSON(w, result)
+}
+
+// decodeJSON decodes a JSON protocol request body.
+func decodeJSON(body []byte, v interface{}) *apiError {
+	if err := json.Unmarshal(body, v); err != nil {
+		return newError(http.StatusBadRequest, "SerializationException", "%s", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(b) //nolint:errcheck
+}
+
+func requestID() string {
+	return "00000000-0000-0000-0000-000000000000"
+}
+
+// epochSeconds returns t as the number of seconds since the Unix epoch, as used in JSON protocol timestamps.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+// Tags
+
+// Tag is a resource tag in the query and JSON protocol services.
+type Tag struct {
+	Key   string `xml:"Key" json:"Key"`
+	Value string `xml:"Value" json:"Value"`
+}
+
+func tagList(tags map[string]string) []Tag {
+	keys := make([]string, 0, len(tags))
+
+	for k := range tags {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	l := make([]Tag, 0, len(keys))
+
+	for _, k := range keys {
+		l = append(l, Tag{Key: k, Value: tags[k]})
+	}
+
+	return l
+}
+
+func copyMap(m map[string]string) map[string]string {
+	n := make(map[string]string, len(m))
+
+	for k, v := range m {
+		n[k] = v
+	}
+
+	return n
+}
+
+// STS
+
+func (s *Server) sts(action string, q query) (interface{}, *apiError) {
+	switch action {
+	case "GetCallerIdentity":
+		return &struct {
+			Account string `xml:"Account"`
+			Arn     string `xml:"Arn"`
+			UserID  string `xml:"UserId"`
+		}{
+			Account: AccountID,
+			Arn:     arn("iam", "", "user/fakeaws"),
+			UserID:  "AIDAFAKEAWS",
+		}, nil
+	default:
+		return nil, errInvalidAction("sts", action)
+	}
+}
@@ -0,0 +1,476 @@
+package fakeaws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func newTestSession(s *Server) *session.Session {
+	return session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(s.URL()),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String(Region),
+	}))
+}
+
+func expectErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+
+	if err, ok := err.(awserr.Error); !ok || err.Code() != code {
+		t.Errorf("got error %v, expected %s", err, code)
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	endpoints := s.Endpoints()
+
+	for _, service := range Services {
+		if endpoints[service] != s.URL() {
+			t.Errorf("%s: got endpoint %q, expected %q", service, endpoints[service], s.URL())
+		}
+	}
+}
+
+func TestUnhandledAction(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	_, err := sqs.New(newTestSession(s)).PurgeQueue(&sqs.PurgeQueueInput{QueueUrl: aws.String(s.URL() + "/" + AccountID + "/test")})
+
+	expectErrorCode(t, err, "InvalidAction")
+
+	if len(s.UnhandledAction) != 1 || s.UnhandledAction[0] != "sqs:PurgeQueue" {
+		t.Errorf("got unhandled actions %v, expected [sqs:PurgeQueue]", s.UnhandledAction)
+	}
+}
+
+func TestSTS(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	output, err := sts.New(newTestSession(s)).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("getting caller identity: %s", err)
+	}
+
+	if got := aws.StringValue(output.Account); got != AccountID {
+		t.Errorf("got account ID %s, expected %s", got, AccountID)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	conn := sqs.New(newTestSession(s))
+
+	createOutput, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		Attributes: aws.StringMap(map[string]string{"DelaySeconds": "10"}),
+		QueueName:  aws.String("test"),
+		Tags:       aws.StringMap(map[string]string{"Name": "test"}),
+	})
+
+	if err != nil {
+		t.Fatalf("creating queue: %s", err)
+	}
+
+	queueURL := createOutput.QueueUrl
+
+	if _, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(map[string]string{"VisibilityTimeout": "60"}),
+		QueueUrl:   queueURL,
+	}); err != nil {
+		t.Fatalf("setting queue attributes: %s", err)
+	}
+
+	attributesOutput, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       queueURL,
+	})
+
+	if err != nil {
+		t.Fatalf("getting queue attributes: %s", err)
+	}
+
+	attributes := aws.StringValueMap(attributesOutput.Attributes)
+
+	for k, expected := range map[string]string{
+		"DelaySeconds":      "10",
+		"QueueArn":          "arn:aws:sqs:us-west-2:123456789012:test",
+		"VisibilityTimeout": "60",
+	} {
+		if got := attributes[k]; got != expected {
+			t.Errorf("attribute %s: got %q, expected %q", k, got, expected)
+		}
+	}
+
+	if _, err := conn.TagQueue(&sqs.TagQueueInput{QueueUrl: queueURL, Tags: aws.StringMap(map[string]string{"Key": "value"})}); err != nil {
+		t.Fatalf("tagging queue: %s", err)
+	}
+
+	if _, err := conn.UntagQueue(&sqs.UntagQueueInput{QueueUrl: queueURL, TagKeys: aws.StringSlice([]string{"Name"})}); err != nil {
+		t.Fatalf("untagging queue: %s", err)
+	}
+
+	tagsOutput, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: queueURL})
+
+	if err != nil {
+		t.Fatalf("listing queue tags: %s", err)
+	}
+
+	if tags := aws.StringValueMap(tagsOutput.Tags); len(tags) != 1 || tags["Key"] != "value" {
+		t.Errorf("got tags %v, expected map[Key:value]", tags)
+	}
+
+	listOutput, err := conn.ListQueues(&sqs.ListQueuesInput{})
+
+	if err != nil {
+		t.Fatalf("listing queues: %s", err)
+	}
+
+	if got := aws.StringValueSlice(listOutput.QueueUrls); len(got) != 1 || got[0] != aws.StringValue(queueURL) {
+		t.Errorf("got queue URLs %v, expected [%s]", got, aws.StringValue(queueURL))
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: queueURL}); err != nil {
+		t.Fatalf("deleting queue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: queueURL})
+
+	expectErrorCode(t, err, sqs.ErrCodeQueueDoesNotExist)
+}
+
+func TestSNS(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	conn := sns.New(newTestSession(s))
+
+	createOutput, err := conn.CreateTopic(&sns.CreateTopicInput{
+		Attributes: aws.StringMap(map[string]string{"DisplayName": "Test"}),
+		Name:       aws.String("test"),
+		Tags:       []*sns.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	})
+
+	if err != nil {
+		t.Fatalf("creating topic: %s", err)
+	}
+
+	topicArn := createOutput.TopicArn
+
+	if got, expected := aws.StringValue(topicArn), "arn:aws:sns:us-west-2:123456789012:test"; got != expected {
+		t.Errorf("got topic ARN %s, expected %s", got, expected)
+	}
+
+	if _, err := conn.SetTopicAttributes(&sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Updated"),
+		TopicArn:       topicArn,
+	}); err != nil {
+		t.Fatalf("setting topic attributes: %s", err)
+	}
+
+	attributesOutput, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: topicArn})
+
+	if err != nil {
+		t.Fatalf("getting topic attributes: %s", err)
+	}
+
+	if got := aws.StringValue(attributesOutput.Attributes["DisplayName"]); got != "Updated" {
+		t.Errorf("got display name %q, expected %q", got, "Updated")
+	}
+
+	if _, err := conn.UntagResource(&sns.UntagResourceInput{ResourceArn: topicArn, TagKeys: aws.StringSlice([]string{"Name"})}); err != nil {
+		t.Fatalf("untagging topic: %s", err)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: topicArn})
+
+	if err != nil {
+		t.Fatalf("listing topic tags: %s", err)
+	}
+
+	if len(tagsOutput.Tags) != 0 {
+		t.Errorf("got tags %v, expected none", tagsOutput.Tags)
+	}
+
+	if _, err := conn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: topicArn}); err != nil {
+		t.Fatalf("deleting topic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: topicArn})
+
+	expectErrorCode(t, err, sns.ErrCodeNotFoundException)
+}
+
+func TestSSM(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	conn := ssm.New(newTestSession(s))
+
+	input := &ssm.PutParameterInput{
+		Name:  aws.String("/test"),
+		Tags:  []*ssm.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+		Type:  aws.String(ssm.ParameterTypeString),
+		Value: aws.String("one"),
+	}
+
+	if _, err := conn.PutParameter(input); err != nil {
+		t.Fatalf("putting parameter: %s", err)
+	}
+
+	_, err := conn.PutParameter(input)
+
+	expectErrorCode(t, err, ssm.ErrCodeParameterAlreadyExists)
+
+	putOutput, err := conn.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String("/test"),
+		Overwrite: aws.Bool(true),
+		Value:     aws.String("two"),
+	})
+
+	if err != nil {
+		t.Fatalf("overwriting parameter: %s", err)
+	}
+
+	if got := aws.Int64Value(putOutput.Version); got != 2 {
+		t.Errorf("got version %d, expected 2", got)
+	}
+
+	getOutput, err := conn.GetParameter(&ssm.GetParameterInput{Name: aws.String("/test")})
+
+	if err != nil {
+		t.Fatalf("getting parameter: %s", err)
+	}
+
+	if got := aws.StringValue(getOutput.Parameter.Value); got != "two" {
+		t.Errorf("got value %q, expected %q", got, "two")
+	}
+
+	if got, expected := aws.StringValue(getOutput.Parameter.ARN), "arn:aws:ssm:us-west-2:123456789012:parameter/test"; got != expected {
+		t.Errorf("got ARN %s, expected %s", got, expected)
+	}
+
+	describeOutput, err := conn.DescribeParameters(&ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: aws.StringSlice([]string{"/test"}),
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("describing parameters: %s", err)
+	}
+
+	if len(describeOutput.Parameters) != 1 || aws.StringValue(describeOutput.Parameters[0].Tier) != ssm.ParameterTierStandard {
+		t.Errorf("got parameters %v, expected one standard tier parameter", describeOutput.Parameters)
+	}
+
+	tagsOutput, err := conn.ListTagsForResource(&ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String("/test"),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+	})
+
+	if err != nil {
+		t.Fatalf("listing parameter tags: %s", err)
+	}
+
+	if len(tagsOutput.TagList) != 1 || aws.StringValue(tagsOutput.TagList[0].Value) != "test" {
+		t.Errorf("got tags %v, expected Name=test", tagsOutput.TagList)
+	}
+
+	if _, err := conn.DeleteParameter(&ssm.DeleteParameterInput{Name: aws.String("/test")}); err != nil {
+		t.Fatalf("deleting parameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(&ssm.GetParameterInput{Name: aws.String("/test")})
+
+	expectErrorCode(t, err, ssm.ErrCodeParameterNotFound)
+}
+
+func TestIAM(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	conn := iam.New(newTestSession(s))
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 aws.String("test"),
+		Tags:                     []*iam.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	}); err != nil {
+		t.Fatalf("creating role: %s", err)
+	}
+
+	if _, err := conn.UpdateRole(&iam.UpdateRoleInput{
+		Description:        aws.String("updated"),
+		MaxSessionDuration: aws.Int64(7200),
+		RoleName:           aws.String("test"),
+	}); err != nil {
+		t.Fatalf("updating role: %s", err)
+	}
+
+	if _, err := conn.AttachRolePolicy(&iam.AttachRolePolicyInput{
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("attaching role policy: %s", err)
+	}
+
+	getOutput, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("getting role: %s", err)
+	}
+
+	role := getOutput.Role
+
+	if got, expected := aws.StringValue(role.Arn), "arn:aws:iam::123456789012:role/test"; got != expected {
+		t.Errorf("got ARN %s, expected %s", got, expected)
+	}
+
+	if got := aws.StringValue(role.Description); got != "updated" {
+		t.Errorf("got description %q, expected %q", got, "updated")
+	}
+
+	if got := aws.Int64Value(role.MaxSessionDuration); got != 7200 {
+		t.Errorf("got max session duration %d, expected 7200", got)
+	}
+
+	if role.CreateDate == nil || len(role.Tags) != 1 {
+		t.Errorf("got role %v, expected create date and one tag", role)
+	}
+
+	_, err = conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")})
+
+	expectErrorCode(t, err, iam.ErrCodeDeleteConflictException)
+
+	attachedOutput, err := conn.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{RoleName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("listing attached role policies: %s", err)
+	}
+
+	if len(attachedOutput.AttachedPolicies) != 1 || aws.StringValue(attachedOutput.AttachedPolicies[0].PolicyName) != "ReadOnlyAccess" {
+		t.Errorf("got attached policies %v, expected ReadOnlyAccess", attachedOutput.AttachedPolicies)
+	}
+
+	if _, err := conn.DetachRolePolicy(&iam.DetachRolePolicyInput{
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+		RoleName:  aws.String("test"),
+	}); err != nil {
+		t.Fatalf("detaching role policy: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("deleting role: %s", err)
+	}
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	expectErrorCode(t, err, iam.ErrCodeNoSuchEntityException)
+}
+
+func TestDynamoDB(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	conn := dynamodb.New(newTestSession(s))
+
+	createOutput, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{AttributeName: aws.String("id"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)},
+		},
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+		},
+		TableName: aws.String("test"),
+		Tags:      []*dynamodb.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+	})
+
+	if err != nil {
+		t.Fatalf("creating table: %s", err)
+	}
+
+	tableArn := createOutput.TableDescription.TableArn
+
+	if _, err := conn.UpdateTimeToLive(&dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String("test"),
+		TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+			AttributeName: aws.String("expires"),
+			Enabled:       aws.Bool(true),
+		},
+	}); err != nil {
+		t.Fatalf("updating time to live: %s", err)
+	}
+
+	describeOutput, err := conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("describing table: %s", err)
+	}
+
+	table := describeOutput.Table
+
+	if got := aws.StringValue(table.TableStatus); got != dynamodb.TableStatusActive {
+		t.Errorf("got status %s, expected %s", got, dynamodb.TableStatusActive)
+	}
+
+	if got := aws.StringValue(table.BillingModeSummary.BillingMode); got != dynamodb.BillingModePayPerRequest {
+		t.Errorf("got billing mode %s, expected %s", got, dynamodb.BillingModePayPerRequest)
+	}
+
+	if len(table.KeySchema) != 1 || aws.StringValue(table.KeySchema[0].AttributeName) != "id" {
+		t.Errorf("got key schema %v, expected hash key id", table.KeySchema)
+	}
+
+	ttlOutput, err := conn.DescribeTimeToLive(&dynamodb.DescribeTimeToLiveInput{TableName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("describing time to live: %s", err)
+	}
+
+	if got := aws.StringValue(ttlOutput.TimeToLiveDescription.AttributeName); got != "expires" {
+		t.Errorf("got time to live attribute %q, expected %q", got, "expires")
+	}
+
+	tagsOutput, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{ResourceArn: tableArn})
+
+	if err != nil {
+		t.Fatalf("listing table tags: %s", err)
+	}
+
+	if len(tagsOutput.Tags) != 1 {
+		t.Errorf("got tags %v, expected one", tagsOutput.Tags)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("deleting table: %s", err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	expectErrorCode(t, err, dynamodb.ErrCodeResourceNotFoundException)
+}
@@ -0,0 +1,389 @@
+package fakeaws
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IAMRole is a fake IAM role.
+type IAMRole struct {
+	AssumeRolePolicyDocument string
+	AttachedPolicyArns       []string
+	CreateDate               time.Time
+	Description              string
+	InlinePolicies           map[string]string
+	MaxSessionDuration       int64
+	Name                     string
+	Path                     string
+	PermissionsBoundary      string
+	RoleID                   string
+	Tags                     map[string]string
+}
+
+func (r *IAMRole) arn() string {
+	return arn("iam", "", "role"+r.Path+r.Name)
+}
+
+type iamRole struct {
+	Arn                      string                  `xml:"Arn"`
+	AssumeRolePolicyDocument string                  `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string                  `xml:"CreateDate"`
+	Description              string                  `xml:"Description,omitempty"`
+	MaxSessionDuration       int64                   `xml:"MaxSessionDuration"`
+	Path                     string                  `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundary `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                  `xml:"RoleId"`
+	RoleName                 string                  `xml:"RoleName"`
+	Tags                     []Tag                   `xml:"Tags>member,omitempty"`
+}
+
+type iamPermissionsBoundary struct {
+	PermissionsBoundaryArn  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+type iamAttachedPolicy struct {
+	PolicyArn  string `xml:"PolicyArn"`
+	PolicyName string `xml:"PolicyName"`
+}
+
+func (r *IAMRole) output() *iamRole {
+	output := &iamRole{
+		Arn:                      r.arn(),
+		AssumeRolePolicyDocument: url.QueryEscape(r.AssumeRolePolicyDocument),
+		CreateDate:               r.CreateDate.UTC().Format(time.RFC3339),
+		Description:              r.Description,
+		MaxSessionDuration:       r.MaxSessionDuration,
+		Path:                     r.Path,
+		RoleID:                   r.RoleID,
+		RoleName:                 r.Name,
+		Tags:                     tagList(r.Tags),
+	}
+
+	if r.PermissionsBoundary != "" {
+		output.PermissionsBoundary = &iamPermissionsBoundary{
+			PermissionsBoundaryArn:  r.PermissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return output
+}
+
+func (s *Server) iamRole(name string) (*IAMRole, *apiError) {
+	role, ok := s.IAMRoles[name]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NoSuchEntity", "The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+func (s *Server) iam(action string, q query) (interface{}, *apiError) {
+	switch action {
+	case "AttachRolePolicy":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		policyArn := q.get("PolicyArn")
+
+		for _, v := range role.AttachedPolicyArns {
+			if v == policyArn {
+				return nil, nil
+			}
+		}
+
+		role.AttachedPolicyArns = append(role.AttachedPolicyArns, policyArn)
+
+		return nil, nil
+
+	case "CreateRole":
+		name := q.get("RoleName")
+
+		if _, ok := s.IAMRoles[name]; ok {
+			return nil, newError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+		}
+
+		role := &IAMRole{
+			AssumeRolePolicyDocument: q.get("AssumeRolePolicyDocument"),
+			CreateDate:               time.Now(),
+			Description:              q.get("Description"),
+			InlinePolicies:           make(map[string]string),
+			MaxSessionDuration:       3600,
+			Name:                     name,
+			Path:                     "/",
+			PermissionsBoundary:      q.get("PermissionsBoundary"),
+			RoleID:                   s.nextID("AROA"),
+			Tags:                     q.mapEntries("Tags.member", "Key", "Value"),
+		}
+
+		if v := q.get("MaxSessionDuration"); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+
+			if err != nil {
+				return nil, newError(http.StatusBadRequest, "ValidationError", "invalid MaxSessionDuration: %s", v)
+			}
+
+			role.MaxSessionDuration = n
+		}
+
+		if v := q.get("Path"); v != "" {
+			role.Path = v
+		}
+
+		s.IAMRoles[name] = role
+
+		return &struct {
+			Role *iamRole `xml:"Role"`
+		}{role.output()}, nil
+
+	case "DeleteRole":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(role.AttachedPolicyArns) > 0 || len(role.InlinePolicies) > 0 {
+			return nil, newError(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must detach all policies first.")
+		}
+
+		delete(s.IAMRoles, role.Name)
+
+		return nil, nil
+
+	case "DeleteRolePermissionsBoundary":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		role.PermissionsBoundary = ""
+
+		return nil, nil
+
+	case "DeleteRolePolicy":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := q.get("PolicyName")
+
+		if _, ok := role.InlinePolicies[name]; !ok {
+			return nil, newError(http.StatusNotFound, "NoSuchEntity", "The role policy with name %s cannot be found.", name)
+		}
+
+		delete(role.InlinePolicies, name)
+
+		return nil, nil
+
+	case "DetachRolePolicy":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		policyArn := q.get("PolicyArn")
+
+		for i, v := range role.AttachedPolicyArns {
+			if v == policyArn {
+				role.AttachedPolicyArns = append(role.AttachedPolicyArns[:i], role.AttachedPolicyArns[i+1:]...)
+				return nil, nil
+			}
+		}
+
+		return nil, newError(http.StatusNotFound, "NoSuchEntity", "Policy %s was not found.", policyArn)
+
+	case "GetRole":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &struct {
+			Role *iamRole `xml:"Role"`
+		}{role.output()}, nil
+
+	case "GetRolePolicy":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := q.get("PolicyName")
+		document, ok := role.InlinePolicies[name]
+
+		if !ok {
+			return nil, newError(http.StatusNotFound, "NoSuchEntity", "The role policy with name %s cannot be found.", name)
+		}
+
+		return &struct {
+			PolicyDocument string `xml:"PolicyDocument"`
+			PolicyName     string `xml:"PolicyName"`
+			RoleName       string `xml:"RoleName"`
+		}{url.QueryEscape(document), name, role.Name}, nil
+
+	case "ListAttachedRolePolicies":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		var policies []iamAttachedPolicy
+
+		for _, v := range role.AttachedPolicyArns {
+			policies = append(policies, iamAttachedPolicy{
+				PolicyArn:  v,
+				PolicyName: v[strings.LastIndex(v, "/")+1:],
+			})
+		}
+
+		return &struct {
+			AttachedPolicies []iamAttachedPolicy `xml:"AttachedPolicies>member"`
+			IsTruncated      bool                `xml:"IsTruncated"`
+		}{AttachedPolicies: policies}, nil
+
+	case "ListInstanceProfilesForRole":
+		if _, err := s.iamRole(q.get("RoleName")); err != nil {
+			return nil, err
+		}
+
+		return &struct {
+			InstanceProfiles string `xml:"InstanceProfiles"`
+			IsTruncated      bool   `xml:"IsTruncated"`
+		}{}, nil
+
+	case "ListRolePolicies":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, 0, len(role.InlinePolicies))
+
+		for name := range role.InlinePolicies {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return &struct {
+			IsTruncated bool     `xml:"IsTruncated"`
+			PolicyNames []string `xml:"PolicyNames>member"`
+		}{PolicyNames: names}, nil
+
+	case "PutRolePermissionsBoundary":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		role.PermissionsBoundary = q.get("PermissionsBoundary")
+
+		return nil, nil
+
+	case "PutRolePolicy":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		role.InlinePolicies[q.get("PolicyName")] = q.get("PolicyDocument")
+
+		return nil, nil
+
+	case "TagRole":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range q.mapEntries("Tags.member", "Key", "Value") {
+			role.Tags[k] = v
+		}
+
+		return nil, nil
+
+	case "UntagRole":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range q.list("TagKeys.member") {
+			delete(role.Tags, k)
+		}
+
+		return nil, nil
+
+	case "UpdateAssumeRolePolicy":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		role.AssumeRolePolicyDocument = q.get("PolicyDocument")
+
+		return nil, nil
+
+	case "UpdateRole":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := q["Description"]; ok {
+			role.Description = q.get("Description")
+		}
+
+		if v := q.get("MaxSessionDuration"); v != "" {
+			n, err := strconv.ParseInt(v, 10, 64)
+
+			if err != nil {
+				return nil, newError(http.StatusBadRequest, "ValidationError", "invalid MaxSessionDuration: %s", v)
+			}
+
+			role.MaxSessionDuration = n
+		}
+
+		return nil, nil
+
+	case "UpdateRoleDescription":
+		role, err := s.iamRole(q.get("RoleName"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		role.Description = q.get("Description")
+
+		return &struct {
+			Role *iamRole `xml:"Role"`
+		}{role.output()}, nil
+
+	default:
+		return nil, errInvalidAction("iam", action)
+	}
+}
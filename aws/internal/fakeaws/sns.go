@@ -0,0 +1,143 @@
+package fakeaws
+
+import (
+	"net/http"
+	"strings"
+)
+
+// SNSTopic is a fake SNS topic.
+type SNSTopic struct {
+	Arn        string
+	Attributes map[string]string
+	Tags       map[string]string
+}
+
+type snsAttributeEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func (s *Server) snsTopic(topicArn string) (*SNSTopic, *apiError) {
+	topic, ok := s.SNSTopics[topicArn]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (s *Server) sns(action string, q query) (interface{}, *apiError) {
+	switch action {
+	case "CreateTopic":
+		name := q.get("Name")
+		topicArn := arn("sns", Region, name)
+
+		if _, ok := s.SNSTopics[topicArn]; !ok {
+			topic := &SNSTopic{
+				Arn:        topicArn,
+				Attributes: q.mapEntries("Attributes.entry", "key", "value"),
+				Tags:       q.mapEntries("Tags.member", "Key", "Value"),
+			}
+
+			topic.Attributes["Owner"] = AccountID
+			topic.Attributes["TopicArn"] = topicArn
+
+			if _, ok := topic.Attributes["DisplayName"]; !ok {
+				topic.Attributes["DisplayName"] = ""
+			}
+
+			if _, ok := topic.Attributes["Policy"]; !ok {
+				topic.Attributes["Policy"] = snsDefaultTopicPolicy(topicArn)
+			}
+
+			if strings.HasSuffix(name, ".fifo") {
+				topic.Attributes["FifoTopic"] = "true"
+			}
+
+			s.SNSTopics[topicArn] = topic
+		}
+
+		return &struct {
+			TopicArn string `xml:"TopicArn"`
+		}{topicArn}, nil
+
+	case "DeleteTopic":
+		delete(s.SNSTopics, q.get("TopicArn"))
+
+		return nil, nil
+
+	case "GetTopicAttributes":
+		topic, err := s.snsTopic(q.get("TopicArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		var entries []snsAttributeEntry
+
+		for _, tag := range tagList(topic.Attributes) {
+			entries = append(entries, snsAttributeEntry{Key: tag.Key, Value: tag.Value})
+		}
+
+		return &struct {
+			Attributes []snsAttributeEntry `xml:"Attributes>entry"`
+		}{entries}, nil
+
+	case "ListTagsForResource":
+		topic, err := s.snsTopic(q.get("ResourceArn"))
+
+		if err != nil {
+			return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+		}
+
+		return &struct {
+			Tags []Tag `xml:"Tags>member"`
+		}{tagList(topic.Tags)}, nil
+
+	case "SetTopicAttributes":
+		topic, err := s.snsTopic(q.get("TopicArn"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		topic.Attributes[q.get("AttributeName")] = q.get("AttributeValue")
+
+		return nil, nil
+
+	case "TagResource":
+		topic, err := s.snsTopic(q.get("ResourceArn"))
+
+		if err != nil {
+			return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+		}
+
+		for k, v := range q.mapEntries("Tags.member", "Key", "Value") {
+			topic.Tags[k] = v
+		}
+
+		return nil, nil
+
+	case "UntagResource":
+		topic, err := s.snsTopic(q.get("ResourceArn"))
+
+		if err != nil {
+			return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+		}
+
+		for _, k := range q.list("TagKeys.member") {
+			delete(topic.Tags, k)
+		}
+
+		return nil, nil
+
+	default:
+		return nil, errInvalidAction("sns", action)
+	}
+}
+
+// snsDefaultTopicPolicy returns the access policy of a topic created without a policy.
+func snsDefaultTopicPolicy(topicArn string) string {
+	return `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish","SNS:Receive"],"Resource":"` + topicArn + `","Condition":{"StringEquals":{"AWS:SourceOwner":"` + AccountID + `"}}}]}`
+}
@@ -0,0 +1,215 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sqsErrCodeQueueDoesNotExist = "AWS.SimpleQueueService.NonExistentQueue"
+
+// SQSQueue is a fake SQS queue.
+type SQSQueue struct {
+	Attributes map[string]string
+	Name       string
+	Tags       map[string]string
+	URL        string
+}
+
+// sqsDefaultQueueAttributes are the attribute values of a queue created without attributes.
+var sqsDefaultQueueAttributes = map[string]string{
+	"DelaySeconds":                  "0",
+	"MaximumMessageSize":            "262144",
+	"MessageRetentionPeriod":        "345600",
+	"ReceiveMessageWaitTimeSeconds": "0",
+	"VisibilityTimeout":             "30",
+}
+
+type sqsAttribute struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+func sqsAttributes(m map[string]string) []sqsAttribute {
+	var l []sqsAttribute
+
+	for _, tag := range tagList(m) {
+		l = append(l, sqsAttribute{Name: tag.Key, Value: tag.Value})
+	}
+
+	return l
+}
+
+func (s *Server) sqsQueue(queueURL string) (*SQSQueue, *apiError) {
+	queue, ok := s.SQSQueues[queueURL]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, sqsErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+	}
+
+	return queue, nil
+}
+
+func (s *Server) sqs(action string, q query) (interface{}, *apiError) {
+	switch action {
+	case "CreateQueue":
+		name := q.get("QueueName")
+		attributes := q.mapEntries("Attribute", "Name", "Value")
+		queueURL := fmt.Sprintf("%s/%s/%s", s.URL(), AccountID, name)
+
+		if queue, ok := s.SQSQueues[queueURL]; ok {
+			for k, v := range attributes {
+				if queue.Attributes[k] != v {
+					return nil, newError(http.StatusBadRequest, "QueueAlreadyExists", "A queue already exists with the same name and a different value for attribute %s", k)
+				}
+			}
+
+			return &struct {
+				QueueURL string `xml:"QueueUrl"`
+			}{queueURL}, nil
+		}
+
+		queue := &SQSQueue{
+			Attributes: copyMap(sqsDefaultQueueAttributes),
+			Name:       name,
+			Tags:       q.mapEntries("Tag", "Key", "Value"),
+			URL:        queueURL,
+		}
+
+		for k, v := range attributes {
+			queue.Attributes[k] = v
+		}
+
+		now := strconv.FormatInt(time.Now().Unix(), 10)
+		queue.Attributes["CreatedTimestamp"] = now
+		queue.Attributes["LastModifiedTimestamp"] = now
+		queue.Attributes["QueueArn"] = arn("sqs", Region, name)
+
+		if strings.HasSuffix(name, ".fifo") {
+			queue.Attributes["FifoQueue"] = "true"
+		}
+
+		s.SQSQueues[queueURL] = queue
+
+		return &struct {
+			QueueURL string `xml:"QueueUrl"`
+		}{queueURL}, nil
+
+	case "DeleteQueue":
+		queue, err := s.sqsQueue(q.get("QueueUrl"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		delete(s.SQSQueues, queue.URL)
+
+		return nil, nil
+
+	case "GetQueueAttributes":
+		queue, err := s.sqsQueue(q.get("QueueUrl"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		names := q.list("AttributeName")
+		attributes := make(map[string]string)
+
+		for k, v := range queue.Attributes {
+			for _, name := range names {
+				if name == "All" || name == k {
+					attributes[k] = v
+				}
+			}
+		}
+
+		return &struct {
+			Attributes []sqsAttribute `xml:"Attribute"`
+		}{sqsAttributes(attributes)}, nil
+
+	case "GetQueueUrl":
+		for _, queue := range s.SQSQueues {
+			if queue.Name == q.get("QueueName") {
+				return &struct {
+					QueueURL string `xml:"QueueUrl"`
+				}{queue.URL}, nil
+			}
+		}
+
+		return nil, newError(http.StatusBadRequest, sqsErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+
+	case "ListQueueTags":
+		queue, err := s.sqsQueue(q.get("QueueUrl"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &struct {
+			Tags []Tag `xml:"Tag"`
+		}{tagList(queue.Tags)}, nil
+
+	case "ListQueues":
+		var queueURLs []string
+
+		for _, queue := range s.SQSQueues {
+			if strings.HasPrefix(queue.Name, q.get("QueueNamePrefix")) {
+				queueURLs = append(queueURLs, queue.URL)
+			}
+		}
+
+		sort.Strings(queueURLs)
+
+		return &struct {
+			QueueURLs []string `xml:"QueueUrl"`
+		}{queueURLs}, nil
+
+	case "SetQueueAttributes":
+		queue, err := s.sqsQueue(q.get("QueueUrl"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range q.mapEntries("Attribute", "Name", "Value") {
+			queue.Attributes[k] = v
+		}
+
+		queue.Attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+
+		return nil, nil
+
+	case "TagQueue":
+		queue, err := s.sqsQueue(q.get("QueueUrl"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range q.mapEntries("Tag", "Key", "Value") {
+			queue.Tags[k] = v
+		}
+
+		return nil, nil
+
+	case "UntagQueue":
+		queue, err := s.sqsQueue(q.get("QueueUrl"))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range q.list("TagKey") {
+			delete(queue.Tags, k)
+		}
+
+		return nil, nil
+
+	default:
+		return nil, errInvalidAction("sqs", action)
+	}
+}
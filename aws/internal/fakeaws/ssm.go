@@ -0,0 +1,314 @@
+package fakeaws
+
+import (
+	"net/http"
+	"time"
+)
+
+// SSMParameter is a fake SSM parameter.
+type SSMParameter struct {
+	AllowedPattern   string
+	DataType         string
+	Description      string
+	KeyID            string
+	LastModifiedDate time.Time
+	Name             string
+	Tags             map[string]string
+	Tier             string
+	Type             string
+	Value            string
+	Version          int64
+}
+
+func (p *SSMParameter) arn() string {
+	name := p.Name
+
+	if len(name) > 0 && name[0] == '/' {
+		name = name[1:]
+	}
+
+	return arn("ssm", Region, "parameter/"+name)
+}
+
+type ssmParameter struct {
+	ARN              string  `json:"ARN"`
+	DataType         string  `json:"DataType"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Type             string  `json:"Type"`
+	Value            string  `json:"Value"`
+	Version          int64   `json:"Version"`
+}
+
+type ssmParameterMetadata struct {
+	AllowedPattern   string  `json:"AllowedPattern,omitempty"`
+	DataType         string  `json:"DataType"`
+	Description      string  `json:"Description,omitempty"`
+	KeyID            string  `json:"KeyId,omitempty"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Tier             string  `json:"Tier"`
+	Type             string  `json:"Type"`
+	Version          int64   `json:"Version"`
+}
+
+func (s *Server) ssmParameter(name string) (*SSMParameter, *apiError) {
+	parameter, ok := s.SSMParameters[name]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "ParameterNotFound", "")
+	}
+
+	return parameter, nil
+}
+
+func (s *Server) ssmTagResource(resourceType, resourceID string) (*SSMParameter, *apiError) {
+	if resourceType != "Parameter" {
+		return nil, newError(http.StatusBadRequest, "InvalidResourceType", "The fake only supports tagging parameters, not %s", resourceType)
+	}
+
+	parameter, ok := s.SSMParameters[resourceID]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "InvalidResourceId", "")
+	}
+
+	return parameter, nil
+}
+
+func (s *Server) ssm(action string, body []byte) (interface{}, *apiError) {
+	switch action {
+	case "AddTagsToResource":
+		var input struct {
+			ResourceID   string `json:"ResourceId"`
+			ResourceType string
+			Tags         []Tag
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		parameter, err := s.ssmTagResource(input.ResourceType, input.ResourceID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range input.Tags {
+			parameter.Tags[tag.Key] = tag.Value
+		}
+
+		return nil, nil
+
+	case "DeleteParameter":
+		var input struct {
+			Name string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		if _, err := s.ssmParameter(input.Name); err != nil {
+			return nil, err
+		}
+
+		delete(s.SSMParameters, input.Name)
+
+		return nil, nil
+
+	case "DescribeParameters":
+		var input struct {
+			ParameterFilters []struct {
+				Key    string
+				Option string
+				Values []string
+			}
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		var names []string
+
+		for _, filter := range input.ParameterFilters {
+			if filter.Key != "Name" || (filter.Option != "" && filter.Option != "Equals") {
+				return nil, newError(http.StatusBadRequest, "InvalidFilterKey", "The fake only supports the Name Equals parameter filter")
+			}
+
+			names = append(names, filter.Values...)
+		}
+
+		parameters := make([]ssmParameterMetadata, 0)
+
+		for _, name := range names {
+			if parameter, ok := s.SSMParameters[name]; ok {
+				parameters = append(parameters, ssmParameterMetadata{
+					AllowedPattern:   parameter.AllowedPattern,
+					DataType:         parameter.DataType,
+					Description:      parameter.Description,
+					KeyID:            parameter.KeyID,
+					LastModifiedDate: epochSeconds(parameter.LastModifiedDate),
+					Name:             parameter.Name,
+					Tier:             parameter.Tier,
+					Type:             parameter.Type,
+					Version:          parameter.Version,
+				})
+			}
+		}
+
+		return &struct {
+			Parameters []ssmParameterMetadata
+		}{parameters}, nil
+
+	case "GetParameter":
+		var input struct {
+			Name string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		parameter, err := s.ssmParameter(input.Name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &struct {
+			Parameter ssmParameter
+		}{
+			Parameter: ssmParameter{
+				ARN:              parameter.arn(),
+				DataType:         parameter.DataType,
+				LastModifiedDate: epochSeconds(parameter.LastModifiedDate),
+				Name:             parameter.Name,
+				Type:             parameter.Type,
+				Value:            parameter.Value,
+				Version:          parameter.Version,
+			},
+		}, nil
+
+	case "ListTagsForResource":
+		var input struct {
+			ResourceID   string `json:"ResourceId"`
+			ResourceType string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		parameter, err := s.ssmTagResource(input.ResourceType, input.ResourceID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &struct {
+			TagList []Tag
+		}{tagList(parameter.Tags)}, nil
+
+	case "PutParameter":
+		var input struct {
+			AllowedPattern string
+			DataType       string
+			Description    string
+			KeyID          string `json:"KeyId"`
+			Name           string
+			Overwrite      bool
+			Tags           []Tag
+			Tier           string
+			Type           string
+			Value          string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		parameter, ok := s.SSMParameters[input.Name]
+
+		switch {
+		case ok && !input.Overwrite:
+			return nil, newError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		case ok && len(input.Tags) > 0:
+			return nil, newError(http.StatusBadRequest, "ValidationException", "Invalid request: tags and overwrite can't be used together.")
+		case !ok:
+			parameter = &SSMParameter{
+				DataType: "text",
+				Name:     input.Name,
+				Tags:     make(map[string]string),
+				Tier:     "Standard",
+			}
+
+			for _, tag := range input.Tags {
+				parameter.Tags[tag.Key] = tag.Value
+			}
+
+			s.SSMParameters[input.Name] = parameter
+		}
+
+		parameter.AllowedPattern = input.AllowedPattern
+		parameter.Description = input.Description
+		parameter.LastModifiedDate = time.Now()
+		parameter.Value = input.Value
+		parameter.Version++
+
+		if input.DataType != "" {
+			parameter.DataType = input.DataType
+		}
+
+		if input.Tier != "" {
+			parameter.Tier = input.Tier
+		}
+
+		if input.Type != "" {
+			parameter.Type = input.Type
+		}
+
+		if parameter.Type == "SecureString" {
+			parameter.KeyID = input.KeyID
+
+			if parameter.KeyID == "" {
+				parameter.KeyID = "alias/aws/ssm"
+			}
+		} else {
+			parameter.KeyID = ""
+		}
+
+		return &struct {
+			Tier    string
+			Version int64
+		}{parameter.Tier, parameter.Version}, nil
+
+	case "RemoveTagsFromResource":
+		var input struct {
+			ResourceID   string `json:"ResourceId"`
+			ResourceType string
+			TagKeys      []string
+		}
+
+		if err := decodeJSON(body, &input); err != nil {
+			return nil, err
+		}
+
+		parameter, err := s.ssmTagResource(input.ResourceType, input.ResourceID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range input.TagKeys {
+			delete(parameter.Tags, k)
+		}
+
+		return nil, nil
+
+	default:
+		return nil, errInvalidAction("ssm", action)
+	}
+}
@@ -0,0 +1,337 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const outputName = "arns_gen.go"
+
+var (
+	packageName = flag.String("package", "", "override package name for generated code")
+)
+
+var placeholderRegexp = regexp.MustCompile(`\{([A-Za-z][A-Za-z0-9]*)\}`)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <spec-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\tDestination package is read from the environment variable $GOPACKAGE by default. Override it with the flag -package.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	destinationPackage := os.Getenv("GOPACKAGE")
+	if *packageName != "" {
+		destinationPackage = *packageName
+	}
+	args := flag.Args()
+	if len(args) == 0 || destinationPackage == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	specFile := args[0]
+
+	spec, err := readSpec(specFile)
+	if err != nil {
+		log.Fatalf("error reading spec file %q: %s", specFile, err)
+	}
+
+	templateData := TemplateData{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: destinationPackage,
+		Spec:               spec,
+	}
+
+	generate(templateData)
+}
+
+// Spec is the declarative description of the ARN formats of AWS service resource types.
+type Spec struct {
+	ResourceTypes []*ResourceTypeSpec
+}
+
+// ResourceTypeSpec describes the ARN format of a single resource type.
+type ResourceTypeSpec struct {
+	// Name is the name used in generated type and function names, e.g. EC2VPC
+	// generates arns.EC2VPC, arns.EC2VPCType and arns.ParseEC2VPC.
+	Name string
+
+	// Description is the human-readable resource type name used in doc comments.
+	Description string
+
+	// Service is the ARN service namespace, e.g. ec2.
+	Service string
+
+	// Type is the resource type, e.g. vpc.
+	Type string
+
+	// Resource is the format of the ARN resource segment, with {Field} placeholders
+	// for the fields, e.g. vpc/{VPCID}.
+	Resource string
+
+	// Fields are the fields of the resource segment, in the order of the placeholders.
+	Fields []*FieldSpec
+
+	// Regional and Account are whether ARNs of the resource type contain a region and an account ID.
+	Regional bool
+	Account  bool
+
+	// Format is the fmt format string of the resource segment.
+	Format string `json:"-"`
+
+	// Prefix is the literal start of the resource segment.
+	Prefix string `json:"-"`
+
+	// Regexp is the regular expression matching the resource segment, with a group per field.
+	Regexp string `json:"-"`
+}
+
+// FieldSpec describes a field of the ARN resource segment.
+type FieldSpec struct {
+	// Name is the Go struct field name, e.g. VPCID.
+	Name string
+
+	// Attribute is the snake case field name, e.g. vpc_id.
+	Attribute string
+
+	// Pattern is the regular expression matching the field value. It must not contain capturing groups.
+	Pattern string
+}
+
+func readSpec(filename string) (*Spec, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(spec); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+
+	for _, t := range spec.ResourceTypes {
+		if t.Name == "" || t.Description == "" || t.Service == "" || t.Type == "" || t.Resource == "" || len(t.Fields) == 0 {
+			return nil, fmt.Errorf("resource type %q: Name, Description, Service, Type, Resource and Fields are required", t.Name)
+		}
+		if names[t.Name] {
+			return nil, fmt.Errorf("resource type %q: duplicate Name", t.Name)
+		}
+		names[t.Name] = true
+
+		if err := t.compile(); err != nil {
+			return nil, fmt.Errorf("resource type %q: %w", t.Name, err)
+		}
+	}
+
+	return spec, nil
+}
+
+// compile sets the format, prefix and regular expression of the resource segment.
+func (t *ResourceTypeSpec) compile() error {
+	matches := placeholderRegexp.FindAllStringSubmatchIndex(t.Resource, -1)
+
+	if len(matches) != len(t.Fields) {
+		return fmt.Errorf("Resource %q has %d placeholders, expected one per field", t.Resource, len(matches))
+	}
+
+	var format, re strings.Builder
+	re.WriteString("^")
+	last := 0
+
+	for i, match := range matches {
+		field := t.Fields[i]
+
+		if field.Name == "" || field.Attribute == "" || field.Pattern == "" {
+			return fmt.Errorf("field %d: Name, Attribute and Pattern are required", i)
+		}
+
+		if name := t.Resource[match[2]:match[3]]; name != field.Name {
+			return fmt.Errorf("field %d: placeholder {%s} does not match field %s", i, name, field.Name)
+		}
+
+		fieldRegexp, err := regexp.Compile(field.Pattern)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		if fieldRegexp.NumSubexp() != 0 {
+			return fmt.Errorf("field %s: Pattern must not contain capturing groups", field.Name)
+		}
+
+		literal := t.Resource[last:match[0]]
+		if i == 0 {
+			t.Prefix = literal
+		}
+
+		format.WriteString(strings.ReplaceAll(literal, "%", "%%") + "%s")
+		re.WriteString(regexp.QuoteMeta(literal) + "(" + field.Pattern + ")")
+		last = match[1]
+	}
+
+	literal := t.Resource[last:]
+	format.WriteString(strings.ReplaceAll(literal, "%", "%%"))
+	re.WriteString(regexp.QuoteMeta(literal) + "$")
+
+	if _, err := regexp.Compile(re.String()); err != nil {
+		return err
+	}
+
+	t.Format = format.String()
+	t.Regexp = re.String()
+
+	return nil
+}
+
+type TemplateData struct {
+	Parameters         string
+	DestinationPackage string
+	Spec               *Spec
+}
+
+func generate(templateData TemplateData) {
+	var buf bytes.Buffer
+
+	tmpl := template.Must(template.New("arns").Funcs(template.FuncMap{"raw": raw}).Parse(arnsTemplate))
+
+	if err := tmpl.Execute(&buf, templateData); err != nil {
+		log.Fatalf("error writing %s: %s", outputName, err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	if err := ioutil.WriteFile(outputName, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// raw returns a Go raw string literal of s, or an interpreted string literal if s contains a backquote.
+func raw(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+
+	return "`" + s + "`"
+}
+
+const arnsTemplate = `// Code generated by "aws/internal/generators/arns/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// resourceTypes are the known resource types, in specification order.
+var resourceTypes = []*ResourceType{
+{{- range .Spec.ResourceTypes }}
+	{{ .Name }}Type,
+{{- end }}
+}
+{{- range .Spec.ResourceTypes }}
+{{- $name := .Name }}
+{{- $description := .Description }}
+
+// {{ .Name }}Type is the resource type of {{ .Description }} ARNs.
+var {{ .Name }}Type = &ResourceType{
+	Service:  {{ printf "%q" .Service }},
+	Type:     {{ printf "%q" .Type }},
+	Regional: {{ .Regional }},
+	Account:  {{ .Account }},
+	Format:   {{ printf "%q" .Resource }},
+	format:   {{ printf "%q" .Format }},
+	prefix:   {{ printf "%q" .Prefix }},
+	resource: regexp.MustCompile({{ raw .Regexp }}),
+	fields: []*resourceField{
+{{- range .Fields }}
+		{name: {{ printf "%q" .Attribute }}, pattern: regexp.MustCompile({{ raw (printf "^%s$" .Pattern) }})},
+{{- end }}
+	},
+	new: func(parsedARN arn.ARN, values []string) Resource {
+		return &{{ .Name }}{
+			Partition: parsedARN.Partition,
+{{- if .Regional }}
+			Region:    parsedARN.Region,
+{{- end }}
+{{- if .Account }}
+			AccountID: parsedARN.AccountID,
+{{- end }}
+{{- range $i, $field := .Fields }}
+			{{ $field.Name }}: values[{{ $i }}],
+{{- end }}
+		}
+	},
+}
+
+// {{ .Name }} holds the fields of {{ .Description }} ARNs, whose resource segment is {{ .Resource }}.
+type {{ .Name }} struct {
+	Partition string
+{{- if .Regional }}
+	Region    string
+{{- end }}
+{{- if .Account }}
+	AccountID string
+{{- end }}
+{{- range .Fields }}
+	{{ .Name }} string
+{{- end }}
+}
+
+// Parse{{ .Name }} parses and validates {{ .Description }} ARNs.
+func Parse{{ .Name }}(s string) (*{{ .Name }}, error) {
+	r, err := {{ .Name }}Type.Parse(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.(*{{ .Name }}), nil
+}
+
+// Build validates and returns the {{ .Description }} ARN.
+func (r *{{ .Name }}) Build() (string, error) {
+	return {{ .Name }}Type.build(r.Partition, {{ if .Regional }}r.Region{{ else }}""{{ end }}, {{ if .Account }}r.AccountID{{ else }}""{{ end }}{{ range .Fields }}, r.{{ .Name }}{{ end }})
+}
+
+// ResourceFields returns the fields of the {{ .Description }} ARN resource segment.
+func (r *{{ .Name }}) ResourceFields() map[string]string {
+	return map[string]string{
+{{- range .Fields }}
+		{{ printf "%q" .Attribute }}: r.{{ .Name }},
+{{- end }}
+	}
+}
+
+// ResourceType returns {{ .Name }}Type.
+func (r *{{ .Name }}) ResourceType() *ResourceType {
+	return {{ .Name }}Type
+}
+{{- end }}
+`
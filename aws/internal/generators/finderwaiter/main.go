@@ -0,0 +1,438 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	finderOutputName = "finder_gen.go"
+	statusOutputName = "status_gen.go"
+	waiterOutputName = "waiter_gen.go"
+)
+
+var (
+	packageName = flag.String("package", "", "override package name for generated code")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] <spec-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\tDestination package is read from the environment variable $GOPACKAGE by default. Override it with the flag -package.\n")
+	fmt.Fprintf(os.Stderr, "\tThe destination package must be named finder or waiter.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	destinationPackage := os.Getenv("GOPACKAGE")
+	if *packageName != "" {
+		destinationPackage = *packageName
+	}
+	args := flag.Args()
+	if len(args) == 0 || destinationPackage == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	specFile := args[0]
+
+	spec, err := readSpec(specFile)
+	if err != nil {
+		log.Fatalf("error reading spec file %q: %s", specFile, err)
+	}
+
+	headerInfo := HeaderInfo{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: destinationPackage,
+		Spec:               spec,
+	}
+
+	switch destinationPackage {
+	case "finder":
+		generate(finderOutputName, headerInfo, finderHeaderTemplate, finderTemplate)
+	case "waiter":
+		generate(statusOutputName, headerInfo, statusHeaderTemplate, statusTemplate)
+		generate(waiterOutputName, headerInfo, waiterHeaderTemplate, waiterTemplate)
+	default:
+		log.Fatalf("unsupported destination package %q, expected finder or waiter", destinationPackage)
+	}
+}
+
+// Spec is the declarative description of the finder and waiter functions for a service.
+type Spec struct {
+	// SourcePackage is the full Go package name of the AWS Go SDK service package.
+	SourcePackage string
+
+	// FinderPackage is the full Go package name of the generated finder package.
+	FinderPackage string
+
+	// Client is the AWS Go SDK service client type name, e.g. Route53Resolver.
+	Client string
+
+	Resources []*ResourceSpec
+
+	// SDKPackage is the name of the AWS Go SDK service package.
+	SDKPackage string `json:"-"`
+}
+
+// ResourceSpec describes the API call, identifier and status of a single resource type.
+type ResourceSpec struct {
+	// Name is the resource name used in function names, e.g. QueryLogConfig
+	// generates finder.QueryLogConfigByID and waiter.QueryLogConfigStatus.
+	Name string
+
+	// Description is the human-readable resource name used in doc comments.
+	Description string
+
+	// Call is the AWS Go SDK function that retrieves a single resource by identifier.
+	Call string
+
+	// IDField is the input field of Call that holds the resource identifier.
+	IDField string
+
+	// ResultField is the output field of Call that holds the resource.
+	// If empty, the output itself is the resource.
+	ResultField string
+
+	// ResultType is the AWS Go SDK type of ResultField. Required if ResultField is set.
+	ResultType string
+
+	// StatusField is the field of the resource that holds its status.
+	// If empty, no status or waiter functions are generated.
+	StatusField string
+
+	// NotFoundErrorCodes are the AWS Go SDK error code constants returned by Call when the resource does not exist.
+	NotFoundErrorCodes []string
+
+	Created *WaiterSpec
+	Deleted *WaiterSpec
+}
+
+// WaiterSpec describes a state transition waited on.
+type WaiterSpec struct {
+	// Pending and Target are the AWS Go SDK status constants of the transition.
+	// An empty Target waits for the resource to no longer exist.
+	Pending []string
+	Target  []string
+
+	// Timeout is the default timeout, e.g. "5m".
+	Timeout string
+
+	// Delay and MinTimeout are optional resource.StateChangeConf settings, e.g. "30s".
+	Delay      string
+	MinTimeout string
+}
+
+// Duration returns a Go expression for a duration string in the spec.
+func (w *WaiterSpec) Duration(s string) string {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		log.Fatalf("invalid duration %q: %s", s, err)
+	}
+
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	default:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	}
+}
+
+// HasWaiters returns true if any resource has a Created or Deleted waiter.
+func (s *Spec) HasWaiters() bool {
+	for _, r := range s.Resources {
+		if r.StatusField != "" && (r.Created != nil || r.Deleted != nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasNotFoundErrorCodes returns true if any resource has not found error codes.
+func (s *Spec) HasNotFoundErrorCodes() bool {
+	for _, r := range s.Resources {
+		if len(r.NotFoundErrorCodes) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasStatus returns true if any resource has a status field.
+func (s *Spec) HasStatus() bool {
+	for _, r := range s.Resources {
+		if r.StatusField != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readSpec(filename string) (*Spec, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(spec); err != nil {
+		return nil, err
+	}
+
+	if spec.SourcePackage == "" || spec.FinderPackage == "" || spec.Client == "" {
+		return nil, fmt.Errorf("SourcePackage, FinderPackage and Client are required")
+	}
+	spec.SDKPackage = path.Base(spec.SourcePackage)
+
+	for _, r := range spec.Resources {
+		if r.Name == "" || r.Description == "" || r.Call == "" || r.IDField == "" {
+			return nil, fmt.Errorf("resource %q: Name, Description, Call and IDField are required", r.Name)
+		}
+		if r.ResultField != "" && r.ResultType == "" {
+			return nil, fmt.Errorf("resource %q: ResultType is required with ResultField", r.Name)
+		}
+		if r.ResultField == "" {
+			r.ResultType = r.Call + "Output"
+		}
+		if r.StatusField == "" && (r.Created != nil || r.Deleted != nil) {
+			return nil, fmt.Errorf("resource %q: StatusField is required with Created or Deleted", r.Name)
+		}
+		for _, w := range []*WaiterSpec{r.Created, r.Deleted} {
+			if w == nil {
+				continue
+			}
+			if len(w.Pending) == 0 || w.Timeout == "" {
+				return nil, fmt.Errorf("resource %q: waiter Pending and Timeout are required", r.Name)
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+type HeaderInfo struct {
+	Parameters         string
+	DestinationPackage string
+	Spec               *Spec
+}
+
+func generate(outputName string, headerInfo HeaderInfo, headerTemplate, bodyTemplate string) {
+	var buf bytes.Buffer
+
+	tmpl := template.Must(template.New("header").Parse(headerTemplate))
+	template.Must(tmpl.New("body").Parse(bodyTemplate))
+
+	if err := tmpl.ExecuteTemplate(&buf, "header", headerInfo); err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+
+	if err := tmpl.ExecuteTemplate(&buf, "body", headerInfo.Spec); err != nil {
+		log.Fatalf("error writing %s: %s", outputName, err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	if err := ioutil.WriteFile(outputName, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+const finderHeaderTemplate = `// Code generated by "aws/internal/generators/finderwaiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .Spec.SourcePackage }}"
+{{- if .Spec.HasNotFoundErrorCodes }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+{{- end }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+`
+
+const finderTemplate = `
+{{- $pkg := .SDKPackage }}
+{{- $client := .Client }}
+{{- range .Resources }}
+
+// {{ .Name }}ByID returns the {{ .Description }} corresponding to the specified identifier.
+// Returns a resource.NotFoundError if no {{ .Description }} is found.
+func {{ .Name }}ByID(conn *{{ $pkg }}.{{ $client }}, id string) (*{{ $pkg }}.{{ .ResultType }}, error) {
+	input := &{{ $pkg }}.{{ .Call }}Input{
+		{{ .IDField }}: aws.String(id),
+	}
+
+	output, err := conn.{{ .Call }}(input)
+{{- range .NotFoundErrorCodes }}
+	if tfawserr.ErrCodeEquals(err, {{ $pkg }}.{{ . }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{- end }}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil{{ if .ResultField }} || output.{{ .ResultField }} == nil{{ end }} {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output{{ if .ResultField }}.{{ .ResultField }}{{ end }}, nil
+}
+{{- end }}
+`
+
+const statusHeaderTemplate = `// Code generated by "aws/internal/generators/finderwaiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+{{ if .Spec.HasStatus }}
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .Spec.SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"{{ .Spec.FinderPackage }}"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+{{- end }}
+`
+
+const statusTemplate = `
+{{- $pkg := .SDKPackage }}
+{{- $client := .Client }}
+{{- range .Resources }}
+{{- if .StatusField }}
+
+// {{ .Name }}Status fetches the {{ .Description }} and its {{ .StatusField }}.
+// Returns a nil result and an empty status if the {{ .Description }} is not found.
+func {{ .Name }}Status(conn *{{ $pkg }}.{{ $client }}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.{{ .Name }}ByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.{{ .StatusField }}), nil
+	}
+}
+{{- end }}
+{{- end }}
+`
+
+const waiterHeaderTemplate = `// Code generated by "aws/internal/generators/finderwaiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+{{ if .Spec.HasWaiters }}
+import (
+	"time"
+
+	"{{ .Spec.SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+{{- end }}
+`
+
+const waiterTemplate = `
+{{- $pkg := .SDKPackage }}
+{{- $client := .Client }}
+{{- range $r := .Resources }}
+{{- if $r.StatusField }}
+{{- with $r.Created }}
+
+// {{ $r.Name }}CreatedTimeout is the default maximum amount of time to wait for a {{ $r.Description }} to be created.
+const {{ $r.Name }}CreatedTimeout = {{ .Duration .Timeout }}
+
+// {{ $r.Name }}Created waits for a {{ $r.Description }} to be created.
+func {{ $r.Name }}Created(conn *{{ $pkg }}.{{ $client }}, id string, timeout time.Duration) (*{{ $pkg }}.{{ $r.ResultType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- range .Pending }}{{ $pkg }}.{{ . }}, {{ end -}} },
+		Target:  []string{ {{- range .Target }}{{ $pkg }}.{{ . }}, {{ end -}} },
+		Refresh: {{ $r.Name }}Status(conn, id),
+		Timeout: timeout,
+{{- if .Delay }}
+		Delay: {{ .Duration .Delay }},
+{{- end }}
+{{- if .MinTimeout }}
+		MinTimeout: {{ .Duration .MinTimeout }},
+{{- end }}
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*{{ $pkg }}.{{ $r.ResultType }}); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+{{- end }}
+{{- with $r.Deleted }}
+
+// {{ $r.Name }}DeletedTimeout is the default maximum amount of time to wait for a {{ $r.Description }} to be deleted.
+const {{ $r.Name }}DeletedTimeout = {{ .Duration .Timeout }}
+
+// {{ $r.Name }}Deleted waits for a {{ $r.Description }} to be deleted.
+func {{ $r.Name }}Deleted(conn *{{ $pkg }}.{{ $client }}, id string, timeout time.Duration) (*{{ $pkg }}.{{ $r.ResultType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- range .Pending }}{{ $pkg }}.{{ . }}, {{ end -}} },
+		Target:  []string{ {{- range .Target }}{{ $pkg }}.{{ . }}, {{ end -}} },
+		Refresh: {{ $r.Name }}Status(conn, id),
+		Timeout: timeout,
+{{- if .Delay }}
+		Delay: {{ .Duration .Delay }},
+{{- end }}
+{{- if .MinTimeout }}
+		MinTimeout: {{ .Duration .MinTimeout }},
+{{- end }}
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*{{ $pkg }}.{{ $r.ResultType }}); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+{{- end }}
+{{- end }}
+{{- end }}
+`
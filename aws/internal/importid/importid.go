@@ -0,0 +1,370 @@
+// Package importid parses and validates composite resource import IDs.
+//
+// Many resources are imported using an ID made up of several values joined by
+// a separator, e.g. REST-API-ID/RESOURCE-ID/HTTP-METHOD. An ID declares the
+// ordered fields and the separator once so that every importer reports the
+// same errors and sets attributes the same way:
+//
+//	var apiGatewayMethodImportID = importid.New("/",
+//		importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+//		importid.Field{Name: "RESOURCE-ID", Attribute: "resource_id"},
+//		importid.Field{Name: "HTTP-METHOD", Attribute: "http_method"},
+//	)
+//
+//	Importer: &schema.ResourceImporter{
+//		State: apiGatewayMethodImportID.StateFunc(func(parts importid.Parts) string {
+//			return fmt.Sprintf("agm-%s-%s-%s", parts[0], parts[1], parts[2])
+//		}),
+//	},
+package importid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FieldType determines how a field value is validated and set.
+type FieldType int
+
+const (
+	// TypeString values are set as is.
+	TypeString FieldType = iota
+	// TypeInt values must be integers and are set as int.
+	TypeInt
+	// TypeBool values must be parseable by strconv.ParseBool and are set as bool.
+	TypeBool
+	// TypeARN values must be ARNs.
+	TypeARN
+	// TypeARNOrName values may be either an ARN or a plain name. ARN values
+	// are set to ARNAttribute when it is configured.
+	TypeARNOrName
+)
+
+// Field describes one value of a composite import ID.
+type Field struct {
+	// Name is the placeholder used for the value in error messages, e.g. REST-API-ID.
+	Name string
+
+	// Attribute is the resource attribute set to the value on import.
+	// Values of fields without an attribute are only returned by Parse.
+	Attribute string
+
+	// ARNAttribute is the resource attribute set instead of Attribute when a
+	// TypeARNOrName value is an ARN.
+	ARNAttribute string
+
+	Type FieldType
+
+	// Optional fields may be omitted from the end of the ID.
+	// They cannot be combined with Greedy or Repeated fields.
+	Optional bool
+
+	// Greedy values may contain the separator. At most one field can be
+	// greedy; the other fields are split off from either end of the ID first.
+	Greedy bool
+
+	// Repeated fields accept one or more separator delimited values.
+	// Only the last field can be repeated and the values are returned joined
+	// by the separator.
+	Repeated bool
+
+	// ValidateFunc is called with each non-empty value after type validation.
+	ValidateFunc func(string) error
+}
+
+func (f Field) greedy() bool {
+	return f.Greedy || f.Repeated
+}
+
+// Parts holds the values of a parsed ID in field order.
+// Omitted optional values are empty.
+type Parts []string
+
+// Int returns the value of the TypeInt field at index i.
+func (p Parts) Int(i int) int {
+	v, _ := strconv.Atoi(p[i])
+	return v
+}
+
+// Bool returns the value of the TypeBool field at index i.
+func (p Parts) Bool(i int) bool {
+	v, _ := strconv.ParseBool(p[i])
+	return v
+}
+
+// ID is a composite import ID schema.
+type ID struct {
+	separator string
+	fields    []Field
+	greedy    int
+	required  int
+	format    string
+}
+
+// New returns an ID schema for the fields joined by separator.
+// It panics if the schema is invalid.
+func New(separator string, fields ...Field) *ID {
+	if separator == "" {
+		panic("importid: empty separator")
+	}
+
+	if len(fields) == 0 {
+		panic("importid: no fields")
+	}
+
+	id := &ID{
+		separator: separator,
+		fields:    fields,
+		greedy:    -1,
+	}
+
+	var format strings.Builder
+	var optional int
+
+	for i, f := range fields {
+		if f.Name == "" {
+			panic(fmt.Sprintf("importid: field %d has no name", i))
+		}
+
+		if f.greedy() {
+			if id.greedy != -1 {
+				panic(fmt.Sprintf("importid: fields %s and %s are both greedy", fields[id.greedy].Name, f.Name))
+			}
+
+			id.greedy = i
+		}
+
+		if f.Repeated && i != len(fields)-1 {
+			panic(fmt.Sprintf("importid: repeated field %s is not the last field", f.Name))
+		}
+
+		if f.Optional {
+			optional++
+		} else if optional > 0 {
+			panic(fmt.Sprintf("importid: required field %s follows an optional field", f.Name))
+		} else {
+			id.required = i + 1
+		}
+
+		if f.Optional {
+			format.WriteString("[")
+		}
+
+		if i > 0 {
+			format.WriteString(separator)
+		}
+
+		format.WriteString(f.Name)
+
+		if f.Repeated {
+			fmt.Fprintf(&format, "[%s%s]*", separator, f.Name)
+		}
+	}
+
+	if id.greedy != -1 && optional > 0 {
+		panic("importid: optional fields cannot be combined with a greedy field")
+	}
+
+	// Optional fields nest, e.g. NAME[/QUALIFIER[/VERSION]].
+	id.format = format.String() + strings.Repeat("]", optional)
+
+	return id
+}
+
+// String returns the expected format of the ID, e.g. REST-API-ID/RESOURCE-ID.
+func (id *ID) String() string {
+	return id.format
+}
+
+// Format joins values into an import ID, omitting trailing empty optional values.
+func (id *ID) Format(values ...string) string {
+	for len(values) > id.required && values[len(values)-1] == "" {
+		values = values[:len(values)-1]
+	}
+
+	return strings.Join(values, id.separator)
+}
+
+// Parse splits and validates s.
+func (id *ID) Parse(s string) (Parts, error) {
+	n := len(id.fields)
+	values := strings.Split(s, id.separator)
+	parts := make(Parts, n)
+
+	if id.greedy == -1 {
+		if s == "" || len(values) < id.required || len(values) > n {
+			return nil, id.newError(s, nil, nil)
+		}
+
+		copy(parts, values)
+	} else {
+		if len(values) < n {
+			return nil, id.newError(s, nil, nil)
+		}
+
+		right := n - 1 - id.greedy
+		copy(parts, values[:id.greedy])
+		copy(parts[id.greedy+1:], values[len(values)-right:])
+		parts[id.greedy] = strings.Join(values[id.greedy:len(values)-right], id.separator)
+	}
+
+	for i, f := range id.fields {
+		if i >= len(values) && f.Optional {
+			continue
+		}
+
+		vs := []string{parts[i]}
+		if f.Repeated {
+			vs = strings.Split(parts[i], id.separator)
+		}
+
+		for _, v := range vs {
+			if err := f.validate(v); err != nil {
+				return nil, id.newError(s, &f, err)
+			}
+		}
+	}
+
+	return parts, nil
+}
+
+// Set sets the field attributes from parts.
+func (id *ID) Set(d *schema.ResourceData, parts Parts) error {
+	for i, f := range id.fields {
+		v := parts[i]
+
+		if v == "" {
+			continue
+		}
+
+		attribute := f.Attribute
+		if f.Type == TypeARNOrName && f.ARNAttribute != "" && looksLikeARN(v) {
+			attribute = f.ARNAttribute
+		}
+
+		if attribute == "" {
+			continue
+		}
+
+		var value interface{} = v
+
+		switch f.Type {
+		case TypeInt:
+			value, _ = strconv.Atoi(v)
+		case TypeBool:
+			value, _ = strconv.ParseBool(v)
+		}
+
+		if err := d.Set(attribute, value); err != nil {
+			return fmt.Errorf("error setting %s: %w", attribute, err)
+		}
+	}
+
+	return nil
+}
+
+// StateFunc returns a schema.StateFunc that parses the import ID and sets the
+// field attributes. If idFunc is not nil the resource ID is set to its result.
+func (id *ID) StateFunc(idFunc func(Parts) string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts, err := id.Parse(d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := id.Set(d, parts); err != nil {
+			return nil, err
+		}
+
+		if idFunc != nil {
+			d.SetId(idFunc(parts))
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func (f Field) validate(v string) error {
+	if v == "" {
+		return fmt.Errorf("must not be empty")
+	}
+
+	switch f.Type {
+	case TypeInt:
+		if _, err := strconv.Atoi(v); err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+	case TypeBool:
+		if _, err := strconv.ParseBool(v); err != nil {
+			return fmt.Errorf("must be true or false")
+		}
+	case TypeARN:
+		if _, err := arn.Parse(v); err != nil {
+			return fmt.Errorf("must be an ARN: %w", err)
+		}
+	case TypeARNOrName:
+		if looksLikeARN(v) {
+			if _, err := arn.Parse(v); err != nil {
+				return fmt.Errorf("must be an ARN or name: %w", err)
+			}
+		}
+	}
+
+	if f.ValidateFunc != nil {
+		return f.ValidateFunc(v)
+	}
+
+	return nil
+}
+
+// looksLikeARN reports whether v should be treated as an ARN rather than a name.
+// Unlike arn.IsARN it matches malformed ARNs too, so they are reported instead
+// of being silently used as a name.
+func looksLikeARN(v string) bool {
+	return strings.HasPrefix(v, "arn:")
+}
+
+// Error is returned by Parse for IDs that do not match the schema.
+type Error struct {
+	// ID is the import ID being parsed.
+	ID string
+
+	// Format is the expected format of the ID.
+	Format string
+
+	// Field is the name of the invalid field, if any.
+	Field string
+
+	Err error
+}
+
+func (id *ID) newError(s string, f *Field, err error) *Error {
+	e := &Error{
+		ID:     s,
+		Format: id.format,
+		Err:    err,
+	}
+
+	if f != nil {
+		e.Field = f.Name
+	}
+
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("unexpected format of ID (%q), expected %s", e.ID, e.Format)
+	}
+
+	return fmt.Sprintf("unexpected format of ID (%q), expected %s: invalid %s: %s", e.ID, e.Format, e.Field, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
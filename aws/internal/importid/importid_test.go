@@ -0,0 +1,422 @@
+package importid
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIDString(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		ID       *ID
+		Expected string
+	}{
+		{
+			TestName: "required",
+			ID: New("/",
+				Field{Name: "REST-API-ID"},
+				Field{Name: "RESOURCE-ID"},
+			),
+			Expected: "REST-API-ID/RESOURCE-ID",
+		},
+		{
+			TestName: "optional",
+			ID: New(":",
+				Field{Name: "NAME"},
+				Field{Name: "QUALIFIER", Optional: true},
+				Field{Name: "VERSION", Optional: true},
+			),
+			Expected: "NAME[:QUALIFIER[:VERSION]]",
+		},
+		{
+			TestName: "repeated",
+			ID: New("_",
+				Field{Name: "SECURITYGROUPID"},
+				Field{Name: "SOURCE", Repeated: true},
+			),
+			Expected: "SECURITYGROUPID_SOURCE[_SOURCE]*",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := testCase.ID.String(); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIDParse(t *testing.T) {
+	required := New("/",
+		Field{Name: "REST-API-ID"},
+		Field{Name: "RESOURCE-ID"},
+	)
+	optional := New(":",
+		Field{Name: "NAME"},
+		Field{Name: "QUALIFIER", Optional: true},
+	)
+	greedy := New("/",
+		Field{Name: "ID"},
+		Field{Name: "PATH", Greedy: true},
+		Field{Name: "SCOPE"},
+	)
+	repeated := New("_",
+		Field{Name: "SECURITYGROUPID"},
+		Field{Name: "SOURCE", Repeated: true, ValidateFunc: func(v string) error {
+			if !strings.Contains(v, ".") {
+				return errors.New("must be a CIDR block")
+			}
+			return nil
+		}},
+	)
+	typed := New(":",
+		Field{Name: "NETWORK_ACL_ID"},
+		Field{Name: "RULE_NUMBER", Type: TypeInt},
+		Field{Name: "EGRESS", Type: TypeBool},
+	)
+	arns := New("#",
+		Field{Name: "LOCATION_ARN", Type: TypeARN},
+		Field{Name: "FUNCTION", Type: TypeARNOrName},
+	)
+
+	testCases := []struct {
+		TestName      string
+		ID            *ID
+		Input         string
+		Expected      Parts
+		ExpectedError string
+	}{
+		{
+			TestName: "required",
+			ID:       required,
+			Input:    "abc/def",
+			Expected: Parts{"abc", "def"},
+		},
+		{
+			TestName:      "required empty",
+			ID:            required,
+			Input:         "",
+			ExpectedError: `unexpected format of ID (""), expected REST-API-ID/RESOURCE-ID`,
+		},
+		{
+			TestName:      "required too few",
+			ID:            required,
+			Input:         "abc",
+			ExpectedError: `unexpected format of ID ("abc"), expected REST-API-ID/RESOURCE-ID`,
+		},
+		{
+			TestName:      "required too many",
+			ID:            required,
+			Input:         "abc/def/ghi",
+			ExpectedError: `unexpected format of ID ("abc/def/ghi"), expected REST-API-ID/RESOURCE-ID`,
+		},
+		{
+			TestName:      "required empty value",
+			ID:            required,
+			Input:         "abc/",
+			ExpectedError: `unexpected format of ID ("abc/"), expected REST-API-ID/RESOURCE-ID: invalid RESOURCE-ID: must not be empty`,
+		},
+		{
+			TestName: "optional omitted",
+			ID:       optional,
+			Input:    "name",
+			Expected: Parts{"name", ""},
+		},
+		{
+			TestName: "optional",
+			ID:       optional,
+			Input:    "name:1",
+			Expected: Parts{"name", "1"},
+		},
+		{
+			TestName:      "optional empty value",
+			ID:            optional,
+			Input:         "name:",
+			ExpectedError: `unexpected format of ID ("name:"), expected NAME[:QUALIFIER]: invalid QUALIFIER: must not be empty`,
+		},
+		{
+			TestName: "greedy",
+			ID:       greedy,
+			Input:    "abc/path/to/thing/REGIONAL",
+			Expected: Parts{"abc", "path/to/thing", "REGIONAL"},
+		},
+		{
+			TestName: "greedy single value",
+			ID:       greedy,
+			Input:    "abc/thing/REGIONAL",
+			Expected: Parts{"abc", "thing", "REGIONAL"},
+		},
+		{
+			TestName:      "greedy too few",
+			ID:            greedy,
+			Input:         "abc/REGIONAL",
+			ExpectedError: `unexpected format of ID ("abc/REGIONAL"), expected ID/PATH/SCOPE`,
+		},
+		{
+			TestName: "repeated",
+			ID:       repeated,
+			Input:    "sg-123_10.0.0.0/16_10.1.0.0/16",
+			Expected: Parts{"sg-123", "10.0.0.0/16_10.1.0.0/16"},
+		},
+		{
+			TestName:      "repeated invalid value",
+			ID:            repeated,
+			Input:         "sg-123_10.0.0.0/16_pl-123",
+			ExpectedError: `unexpected format of ID ("sg-123_10.0.0.0/16_pl-123"), expected SECURITYGROUPID_SOURCE[_SOURCE]*: invalid SOURCE: must be a CIDR block`,
+		},
+		{
+			TestName:      "repeated empty value",
+			ID:            repeated,
+			Input:         "sg-123_10.0.0.0/16_",
+			ExpectedError: `unexpected format of ID ("sg-123_10.0.0.0/16_"), expected SECURITYGROUPID_SOURCE[_SOURCE]*: invalid SOURCE: must not be empty`,
+		},
+		{
+			TestName: "typed",
+			ID:       typed,
+			Input:    "acl-123:100:true",
+			Expected: Parts{"acl-123", "100", "true"},
+		},
+		{
+			TestName:      "typed invalid int",
+			ID:            typed,
+			Input:         "acl-123:one:true",
+			ExpectedError: `unexpected format of ID ("acl-123:one:true"), expected NETWORK_ACL_ID:RULE_NUMBER:EGRESS: invalid RULE_NUMBER: must be an integer`,
+		},
+		{
+			TestName:      "typed invalid bool",
+			ID:            typed,
+			Input:         "acl-123:100:egress",
+			ExpectedError: `unexpected format of ID ("acl-123:100:egress"), expected NETWORK_ACL_ID:RULE_NUMBER:EGRESS: invalid EGRESS: must be true or false`,
+		},
+		{
+			TestName: "ARN and name",
+			ID:       arns,
+			Input:    "arn:aws:datasync:us-west-2:123456789012:location/loc-123#my-function",
+			Expected: Parts{"arn:aws:datasync:us-west-2:123456789012:location/loc-123", "my-function"},
+		},
+		{
+			TestName: "ARN and ARN",
+			ID:       arns,
+			Input:    "arn:aws:datasync:us-west-2:123456789012:location/loc-123#arn:aws:lambda:us-west-2:123456789012:function:my-function",
+			Expected: Parts{"arn:aws:datasync:us-west-2:123456789012:location/loc-123", "arn:aws:lambda:us-west-2:123456789012:function:my-function"},
+		},
+		{
+			TestName:      "invalid ARN",
+			ID:            arns,
+			Input:         "loc-123#my-function",
+			ExpectedError: `unexpected format of ID ("loc-123#my-function"), expected LOCATION_ARN#FUNCTION: invalid LOCATION_ARN: must be an ARN: arn: invalid prefix`,
+		},
+		{
+			TestName:      "invalid ARN or name",
+			ID:            arns,
+			Input:         "arn:aws:datasync:us-west-2:123456789012:location/loc-123#arn:aws:lambda",
+			ExpectedError: `unexpected format of ID ("arn:aws:datasync:us-west-2:123456789012:location/loc-123#arn:aws:lambda"), expected LOCATION_ARN#FUNCTION: invalid FUNCTION: must be an ARN or name: arn: not enough sections`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := testCase.ID.Parse(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.ExpectedError)
+				}
+
+				var e *Error
+				if !errors.As(err, &e) {
+					t.Errorf("expected *Error, got %T", err)
+				}
+
+				if err.Error() != testCase.ExpectedError {
+					t.Errorf("got error %q, expected %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIDFormat(t *testing.T) {
+	id := New(":",
+		Field{Name: "NAME"},
+		Field{Name: "QUALIFIER", Optional: true},
+	)
+
+	if got, expected := id.Format("name", ""), "name"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := id.Format("name", "1"), "name:1"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestIDStateFunc(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"function_arn":  {Type: schema.TypeString, Optional: true},
+		"function_name": {Type: schema.TypeString, Optional: true},
+		"rule_number":   {Type: schema.TypeInt, Optional: true},
+		"egress":        {Type: schema.TypeBool, Optional: true},
+		"qualifier":     {Type: schema.TypeString, Optional: true},
+	}
+
+	id := New("/",
+		Field{Name: "FUNCTION", Attribute: "function_name", ARNAttribute: "function_arn", Type: TypeARNOrName},
+		Field{Name: "RULE_NUMBER", Attribute: "rule_number", Type: TypeInt},
+		Field{Name: "EGRESS", Attribute: "egress", Type: TypeBool},
+		Field{Name: "QUALIFIER", Attribute: "qualifier", Optional: true},
+	)
+
+	stateFunc := id.StateFunc(func(parts Parts) string {
+		return fmt.Sprintf("%s-%s", parts[0], parts[1])
+	})
+
+	testCases := []struct {
+		TestName      string
+		Input         string
+		ExpectedID    string
+		Expected      map[string]interface{}
+		ExpectedError bool
+	}{
+		{
+			TestName:   "name",
+			Input:      "my-function/100/true",
+			ExpectedID: "my-function-100",
+			Expected: map[string]interface{}{
+				"function_arn":  "",
+				"function_name": "my-function",
+				"rule_number":   100,
+				"egress":        true,
+				"qualifier":     "",
+			},
+		},
+		{
+			TestName:   "ARN with qualifier",
+			Input:      "arn:aws:lambda:us-west-2:123456789012:function:my-function/5/false/live",
+			ExpectedID: "arn:aws:lambda:us-west-2:123456789012:function:my-function-5",
+			Expected: map[string]interface{}{
+				"function_arn":  "arn:aws:lambda:us-west-2:123456789012:function:my-function",
+				"function_name": "",
+				"rule_number":   5,
+				"egress":        false,
+				"qualifier":     "live",
+			},
+		},
+		{
+			TestName:      "invalid",
+			Input:         "my-function/100",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+			d.SetId(testCase.Input)
+
+			_, err := stateFunc(d, nil)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := d.Id(); got != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ExpectedID)
+			}
+
+			for k, expected := range testCase.Expected {
+				if got := d.Get(k); got != expected {
+					t.Errorf("got %s %#v, expected %#v", k, got, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNew_invalid(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Fields   []Field
+	}{
+		{
+			TestName: "no fields",
+		},
+		{
+			TestName: "no name",
+			Fields:   []Field{{Name: "ID"}, {}},
+		},
+		{
+			TestName: "required after optional",
+			Fields:   []Field{{Name: "ID"}, {Name: "QUALIFIER", Optional: true}, {Name: "SCOPE"}},
+		},
+		{
+			TestName: "two greedy",
+			Fields:   []Field{{Name: "ID", Greedy: true}, {Name: "PATH", Greedy: true}},
+		},
+		{
+			TestName: "repeated not last",
+			Fields:   []Field{{Name: "SOURCE", Repeated: true}, {Name: "ID"}},
+		},
+		{
+			TestName: "greedy and optional",
+			Fields:   []Field{{Name: "PATH", Greedy: true}, {Name: "QUALIFIER", Optional: true}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+
+			New("/", testCase.Fields...)
+		})
+	}
+}
+
+func TestPartsIntBool(t *testing.T) {
+	id := New(":",
+		Field{Name: "RULE_NUMBER", Type: TypeInt},
+		Field{Name: "EGRESS", Type: TypeBool},
+	)
+
+	parts, err := id.Parse("100:true")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := parts.Int(0), 100; got != expected {
+		t.Errorf("got %d, expected %d", got, expected)
+	}
+
+	if got, expected := parts.Bool(1), true; got != expected {
+		t.Errorf("got %t, expected %t", got, expected)
+	}
+}
@@ -25,6 +25,11 @@ const (
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
 )
 
+// DefaultConfig contains tags to default across all resources.
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
@@ -37,6 +42,25 @@ type IgnoreConfig struct {
 // across all these Go types, we convert them into this Go type.
 type KeyValueTags map[string]*TagData
 
+// GetTags returns the configured default tags, or nil if none are configured.
+func (dc *DefaultConfig) GetTags() KeyValueTags {
+	if dc == nil {
+		return nil
+	}
+
+	return dc.Tags
+}
+
+// MergeTags returns the default tags merged with the given tags.
+// Values of the given tags override default tags with a matching key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
 // IgnoreAws returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAws() KeyValueTags {
 	result := make(KeyValueTags)
@@ -312,6 +336,25 @@ func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags not matching a default tag key and value.
+// Tags whose key matches a default tag but whose value differs are kept,
+// as they override the default.
+func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
+	if dc == nil || dc.Tags == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if defaultV, ok := dc.Tags[k]; !ok || !v.Equal(defaultV) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
 // Only returns matching tag keys.
 func (tags KeyValueTags) Only(onlyTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
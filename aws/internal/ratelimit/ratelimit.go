@@ -0,0 +1,180 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
+)
+
+// SendHandlerName is the name of the request handler added to AWS service clients.
+const SendHandlerName = "terraform-provider-aws.ratelimit.RegistrySendHandler"
+
+// Limit describes the sustained request rate and burst allowed for a service.
+type Limit struct {
+	// RequestsPerSecond is the rate at which request tokens are replenished.
+	// Requests are not limited if zero.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests that can be sent at once.
+	// Defaults to RequestsPerSecond rounded up, with a minimum of 1.
+	Burst int
+}
+
+// Limiter is a token bucket rate limiter.
+// It is safe for concurrent use.
+type Limiter struct {
+	mu     sync.Mutex
+	burst  float64
+	last   time.Time
+	now    func() time.Time
+	rate   float64
+	tokens float64
+}
+
+// NewLimiter returns a Limiter that allows requests at the given rate with the given burst.
+// The bucket starts full.
+func NewLimiter(limit Limit) *Limiter {
+	burst := limit.Burst
+
+	if burst <= 0 {
+		burst = int(math.Ceil(limit.RequestsPerSecond))
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Limiter{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   limit.RequestsPerSecond,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a request is allowed or the context is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// Registry holds per-service rate limits and the limiters created from them.
+// Limiters are created on first use for each service and region,
+// as AWS API rate limits apply per account and region.
+type Registry struct {
+	mu       sync.Mutex
+	limiters map[string]*Limiter
+	limits   map[string]Limit
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		limiters: make(map[string]*Limiter),
+		limits:   make(map[string]Limit),
+	}
+}
+
+// SetLimit sets the rate limit for a service, replacing any existing limit.
+// The service identifier is normalized as for retry rules, e.g. cloudwatchlogs.
+func (r *Registry) SetLimit(service string, limit Limit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.limits[retry.NormalizeServiceID(service)] = limit
+}
+
+// Limiter returns the limiter for a service and region.
+// Returns nil if the service is not rate limited.
+func (r *Registry) Limiter(service, region string) *Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	service = retry.NormalizeServiceID(service)
+	limit, ok := r.limits[service]
+
+	if !ok || limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	key := service + "/" + region
+
+	if v, ok := r.limiters[key]; ok {
+		return v
+	}
+
+	limiter := NewLimiter(limit)
+	r.limiters[key] = limiter
+
+	return limiter
+}
+
+// SendHandler returns a request handler, to be added to the front of the Send handler list of
+// a session or service client, that waits for the service rate limit before each request attempt.
+// The Send handler list must stop on error so that a canceled request is not sent.
+func (r *Registry) SendHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: SendHandlerName,
+		Fn:   r.send,
+	}
+}
+
+func (r *Registry) send(req *request.Request) {
+	limiter := r.Limiter(req.ClientInfo.ServiceID, req.ClientInfo.SigningRegion)
+
+	if limiter == nil {
+		return
+	}
+
+	if err := limiter.Wait(req.Context()); err != nil {
+		req.Error = awserr.New(request.CanceledErrorCode, "request context canceled while waiting for rate limit", err)
+	}
+}
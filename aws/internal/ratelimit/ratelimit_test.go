@@ -0,0 +1,147 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	limiter := NewLimiter(Limit{RequestsPerSecond: 2, Burst: 3})
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if got := limiter.reserve(); got != 0 {
+			t.Fatalf("burst request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	if got, expected := limiter.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	if got, expected := limiter.reserve(), 1*time.Second; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	now = now.Add(10 * time.Second)
+
+	if got := limiter.reserve(); got != 0 {
+		t.Errorf("after refill: got delay %s, expected none", got)
+	}
+}
+
+func TestNewLimiterBurst(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Limit    Limit
+		Expected float64
+	}{
+		{
+			TestName: "configured",
+			Limit:    Limit{RequestsPerSecond: 20, Burst: 100},
+			Expected: 100,
+		},
+		{
+			TestName: "rounded up rate",
+			Limit:    Limit{RequestsPerSecond: 2.5},
+			Expected: 3,
+		},
+		{
+			TestName: "minimum",
+			Limit:    Limit{RequestsPerSecond: 0.2},
+			Expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := NewLimiter(testCase.Limit).burst; got != testCase.Expected {
+				t.Errorf("got %f, expected %f", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLimiterWaitCanceled(t *testing.T) {
+	limiter := NewLimiter(Limit{RequestsPerSecond: 0.001, Burst: 1})
+
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("got error %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestRegistryLimiter(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetLimit("Route 53", Limit{RequestsPerSecond: 5})
+	registry.SetLimit("ec2", Limit{RequestsPerSecond: 0})
+
+	if got := registry.Limiter("ec2", "us-west-2"); got != nil {
+		t.Errorf("expected no limiter for disabled service, got %v", got)
+	}
+
+	if got := registry.Limiter("iam", "us-east-1"); got != nil {
+		t.Errorf("expected no limiter for unconfigured service, got %v", got)
+	}
+
+	first := registry.Limiter("route53", "us-east-1")
+
+	if first == nil {
+		t.Fatal("expected limiter")
+	}
+
+	if got := registry.Limiter("Route 53", "us-east-1"); got != first {
+		t.Error("expected same limiter for same service and region")
+	}
+
+	if got := registry.Limiter("route53", "us-west-2"); got == first {
+		t.Error("expected different limiter for different region")
+	}
+}
+
+func TestRegistrySendHandler(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetLimit("cloudwatchlogs", Limit{RequestsPerSecond: 0.001, Burst: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	newRequest := func() *request.Request {
+		req := &request.Request{
+			ClientInfo:  metadata.ClientInfo{ServiceID: "CloudWatch Logs", SigningRegion: "us-west-2"},
+			Operation:   &request.Operation{Name: "DescribeLogGroups"},
+			HTTPRequest: &http.Request{},
+		}
+		req.SetContext(ctx)
+
+		return req
+	}
+
+	req := newRequest()
+	registry.SendHandler().Fn(req)
+
+	if req.Error != nil {
+		t.Fatalf("first request: unexpected error: %s", req.Error)
+	}
+
+	req = newRequest()
+	registry.SendHandler().Fn(req)
+
+	if err, ok := req.Error.(awserr.Error); !ok || err.Code() != request.CanceledErrorCode {
+		t.Errorf("second request: got error %v, expected %s", req.Error, request.CanceledErrorCode)
+	}
+}
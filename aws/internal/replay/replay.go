@@ -0,0 +1,362 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// EnvVarMode is the environment variable that sets the Mode of acceptance tests using the recorder.
+	EnvVarMode = "TF_ACC_REPLAY_MODE"
+
+	// AccountID replaces AWS account IDs in recorded interactions
+	// and is the account ID of the provider in replay mode.
+	AccountID = "123456789012"
+
+	// ErrCodeInteractionNotFound is the error code returned in replay mode for requests with no recorded interaction.
+	ErrCodeInteractionNotFound = "ReplayInteractionNotFound"
+
+	// RecordHandlerName is the name of the request handler added to AWS service clients in record mode.
+	RecordHandlerName = "terraform-provider-aws.replay.RecordHandler"
+
+	// ReplayHandlerName is the name of the request handler that replaces sending requests in replay mode.
+	ReplayHandlerName = "terraform-provider-aws.replay.ReplayHandler"
+
+	cassetteVersion = 1
+)
+
+// Mode is the operating mode of a Recorder.
+type Mode string
+
+const (
+	// ModeOff sends API requests without recording them.
+	ModeOff Mode = ""
+
+	// ModeRecord sends API requests and records the interactions to the cassette file.
+	ModeRecord Mode = "record"
+
+	// ModeReplay serves API requests from the cassette file without network access.
+	ModeReplay Mode = "replay"
+)
+
+// ModeFromEnv returns the Mode set by the EnvVarMode environment variable.
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(os.Getenv(EnvVarMode)); mode {
+	case ModeOff, ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return ModeOff, fmt.Errorf("unsupported %s value (%s), expected %q or %q", EnvVarMode, mode, ModeRecord, ModeReplay)
+	}
+}
+
+// digitsRegexp matches runs of digits, of which those of length 12 are treated as AWS account IDs,
+// e.g. in ARNs and owner IDs.
+var digitsRegexp = regexp.MustCompile(`[0-9]+`)
+
+// Interaction is a recorded API request and its response.
+// Request and response bodies are scrubbed of account IDs and registered values.
+type Interaction struct {
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	Request    string      `json:"request"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Cassette is the file format of recorded interactions.
+type Cassette struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder records API interactions to, or replays them from, a cassette file.
+type Recorder struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	mode     Mode
+	path     string
+	used     []bool
+	values   []string
+}
+
+// New returns a Recorder for the cassette file at path.
+// In replay mode the cassette file must exist.
+func New(mode Mode, path string) (*Recorder, error) {
+	r := &Recorder{
+		cassette: &Cassette{Version: cassetteVersion},
+		mode:     mode,
+		path:     path,
+	}
+
+	if mode != ModeReplay {
+		return r, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette (%s): %w", path, err)
+	}
+
+	if err := json.Unmarshal(b, r.cassette); err != nil {
+		return nil, fmt.Errorf("error parsing cassette (%s): %w", path, err)
+	}
+
+	if r.cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("unsupported cassette (%s) version %d, expected %d", path, r.cassette.Version, cassetteVersion)
+	}
+
+	r.used = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+// Mode returns the operating mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Scrub registers values, such as randomly generated resource names, that differ between test runs.
+// Values are replaced by placeholders in recorded interactions and placeholders are replaced
+// by the values when replaying, so values must be registered in the same order in each run.
+func (r *Recorder) Scrub(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.values = append(r.values, values...)
+}
+
+func placeholder(i int) string {
+	return fmt.Sprintf("{{replay.value.%d}}", i)
+}
+
+// scrub replaces account IDs and registered values in s.
+func (r *Recorder) scrub(s string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Replace longer values first in case one value contains another.
+	indexes := make([]int, len(r.values))
+
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return len(r.values[indexes[i]]) > len(r.values[indexes[j]])
+	})
+
+	for _, i := range indexes {
+		if v := r.values[i]; v != "" {
+			s = strings.ReplaceAll(s, v, placeholder(i))
+		}
+	}
+
+	return digitsRegexp.ReplaceAllStringFunc(s, func(digits string) string {
+		if len(digits) == len(AccountID) {
+			return AccountID
+		}
+
+		return digits
+	})
+}
+
+// unscrub replaces placeholders in s with the registered values.
+func (r *Recorder) unscrub(s string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, v := range r.values {
+		s = strings.ReplaceAll(s, placeholder(i), v)
+	}
+
+	return s
+}
+
+// Configure adds the recorder's request handlers to the Send handler list of a session or service client.
+func (r *Recorder) Configure(handlers *request.Handlers) {
+	switch r.mode {
+	case ModeRecord:
+		handlers.Send.PushBackNamed(request.NamedHandler{
+			Name: RecordHandlerName,
+			Fn:   r.record,
+		})
+	case ModeReplay:
+		handlers.Send.Swap(corehandlers.SendHandler.Name, request.NamedHandler{
+			Name: ReplayHandlerName,
+			Fn:   r.replay,
+		})
+	}
+}
+
+// Save writes the recorded interactions to the cassette file in record mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+
+	if err != nil {
+		return fmt.Errorf("error encoding cassette (%s): %w", r.path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("error creating cassette (%s) directory: %w", r.path, err)
+	}
+
+	if err := ioutil.WriteFile(r.path, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", r.path, err)
+	}
+
+	return nil
+}
+
+func (r *Recorder) record(req *request.Request) {
+	if req.Error != nil || req.HTTPResponse == nil {
+		return
+	}
+
+	requestBody, err := requestString(req)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to record %s %s request: %s", req.ClientInfo.ServiceID, req.Operation.Name, err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	req.HTTPResponse.Body.Close()
+
+	if err != nil {
+		req.Error = err
+		return
+	}
+
+	req.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := req.HTTPResponse.Header.Clone()
+	header.Del("Date")
+	header.Del("Set-Cookie")
+
+	interaction := &Interaction{
+		Service:    req.ClientInfo.ServiceID,
+		Operation:  req.Operation.Name,
+		Request:    r.scrub(requestBody),
+		StatusCode: req.HTTPResponse.StatusCode,
+		Header:     header,
+		Body:       r.scrub(string(body)),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+}
+
+func (r *Recorder) replay(req *request.Request) {
+	requestBody, err := requestString(req)
+
+	if err != nil {
+		req.Error = err
+		return
+	}
+
+	interaction := r.match(req.ClientInfo.ServiceID, req.Operation.Name, r.scrub(requestBody))
+
+	if interaction == nil {
+		req.Error = awserr.New(ErrCodeInteractionNotFound, fmt.Sprintf("no recorded %s %s interaction in cassette (%s)", req.ClientInfo.ServiceID, req.Operation.Name, r.path), nil)
+		req.Retryable = aws.Bool(false)
+		return
+	}
+
+	body := r.unscrub(interaction.Body)
+
+	req.HTTPResponse = &http.Response{
+		Status:        http.StatusText(interaction.StatusCode),
+		StatusCode:    interaction.StatusCode,
+		Header:        interaction.Header.Clone(),
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+
+	if req.HTTPResponse.Header == nil {
+		req.HTTPResponse.Header = make(http.Header)
+	}
+}
+
+// match returns the first unused interaction for the service and operation,
+// preferring one with the same scrubbed request, and marks it used.
+// Returns nil if there is no such interaction.
+func (r *Recorder) match(service, operation, request string) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	found := -1
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Service != service || interaction.Operation != operation {
+			continue
+		}
+
+		if interaction.Request == request {
+			found = i
+			break
+		}
+
+		if found == -1 {
+			found = i
+		}
+	}
+
+	if found == -1 {
+		return nil
+	}
+
+	r.used[found] = true
+
+	return r.cassette.Interactions[found]
+}
+
+// requestString returns the serialized form of a built request used to match interactions.
+func requestString(req *request.Request) (string, error) {
+	var body []byte
+
+	if req.Body != nil {
+		if _, err := req.Body.Seek(req.BodyStart, io.SeekStart); err != nil {
+			return "", err
+		}
+
+		v, err := ioutil.ReadAll(req.Body)
+
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := req.Body.Seek(req.BodyStart, io.SeekStart); err != nil {
+			return "", err
+		}
+
+		body = v
+	}
+
+	return req.HTTPRequest.Method + " " + req.HTTPRequest.URL.RequestURI() + "\n" + string(body), nil
+}
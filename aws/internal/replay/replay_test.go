@@ -0,0 +1,151 @@
+package replay
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestModeFromEnv(t *testing.T) {
+	defer os.Unsetenv(EnvVarMode)
+
+	for _, value := range []string{"", "record", "replay"} {
+		os.Setenv(EnvVarMode, value)
+
+		if got, err := ModeFromEnv(); err != nil || got != Mode(value) {
+			t.Errorf("%q: got %q, %v", value, got, err)
+		}
+	}
+
+	os.Setenv(EnvVarMode, "rewind")
+
+	if _, err := ModeFromEnv(); err == nil {
+		t.Error("expected error for unsupported mode")
+	}
+}
+
+func TestRecorderScrub(t *testing.T) {
+	r := &Recorder{}
+	r.Scrub("tf-acc-test-123", "tf-acc-test-1234567")
+
+	scrubbed := r.scrub("arn:aws:sqs:us-west-2:987654321098:tf-acc-test-1234567 987654321098:111122223333 tf-acc-test-123 1234567890123")
+	expected := "arn:aws:sqs:us-west-2:123456789012:{{replay.value.1}} 123456789012:123456789012 {{replay.value.0}} 1234567890123"
+
+	if scrubbed != expected {
+		t.Errorf("got %q, expected %q", scrubbed, expected)
+	}
+
+	r = &Recorder{}
+	r.Scrub("tf-acc-test-456", "tf-acc-test-4567890")
+
+	if got, expected := r.unscrub(scrubbed), "arn:aws:sqs:us-west-2:123456789012:tf-acc-test-4567890 123456789012:123456789012 tf-acc-test-456 1234567890123"; got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
+
+func newTestSQSConn(t *testing.T, recorder *Recorder, endpoint string) *sqs.SQS {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(endpoint),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-west-2"),
+	}))
+	recorder.Configure(&sess.Handlers)
+
+	return sqs.New(sess)
+}
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "TestRecorder.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing request: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch r.Form.Get("Action") {
+		case "CreateQueue":
+			fmt.Fprintf(w, `<CreateQueueResponse><CreateQueueResult><QueueUrl>https://sqs.us-west-2.amazonaws.com/987654321098/%s</QueueUrl></CreateQueueResult></CreateQueueResponse>`, r.Form.Get("QueueName"))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	recorder, err := New(ModeRecord, path)
+
+	if err != nil {
+		t.Fatalf("creating recorder: %s", err)
+	}
+
+	recorder.Scrub("tf-acc-test-recorded")
+
+	output, err := newTestSQSConn(t, recorder, server.URL).CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("tf-acc-test-recorded")})
+
+	if err != nil {
+		t.Fatalf("recording: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/987654321098/tf-acc-test-recorded"; got != expected {
+		t.Errorf("recording: got %s, expected %s", got, expected)
+	}
+
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("saving cassette: %s", err)
+	}
+
+	server.Close()
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("reading cassette: %s", err)
+	}
+
+	if strings.Contains(string(b), "987654321098") || strings.Contains(string(b), "tf-acc-test-recorded") {
+		t.Errorf("expected cassette to be scrubbed:\n%s", b)
+	}
+
+	recorder, err = New(ModeReplay, path)
+
+	if err != nil {
+		t.Fatalf("loading cassette: %s", err)
+	}
+
+	recorder.Scrub("tf-acc-test-replayed")
+	conn := newTestSQSConn(t, recorder, server.URL)
+
+	output, err = conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("tf-acc-test-replayed")})
+
+	if err != nil {
+		t.Fatalf("replaying: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), "https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-test-replayed"; got != expected {
+		t.Errorf("replaying: got %s, expected %s", got, expected)
+	}
+
+	_, err = conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("tf-acc-test-replayed")})
+
+	if err, ok := err.(awserr.Error); !ok || err.Code() != ErrCodeInteractionNotFound {
+		t.Errorf("replaying used interaction: got error %v, expected %s", err, ErrCodeInteractionNotFound)
+	}
+}
+
+func TestNewReplayMissingCassette(t *testing.T) {
+	if _, err := New(ModeReplay, filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for missing cassette")
+	}
+}
@@ -0,0 +1,225 @@
+package requestcache
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
+)
+
+const (
+	// CacheableHandlerName is the name of the request handler that serves opted-in requests from the cache.
+	CacheableHandlerName = "terraform-provider-aws.requestcache.CacheableSendHandler"
+
+	// InvalidateHandlerName is the name of the request handler added to AWS service clients
+	// that invalidates cached responses on mutating requests.
+	InvalidateHandlerName = "terraform-provider-aws.requestcache.InvalidateHandler"
+)
+
+// readOnlyOperationPrefixes are the API operation name prefixes of requests that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// IsReadOnlyOperation returns whether the named API operation does not modify resources.
+func IsReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type response struct {
+	body       []byte
+	header     http.Header
+	statusCode int
+}
+
+// Cache holds successful API responses for the lifetime of a provider run.
+// Responses are keyed by service, region, operation and the serialized request input,
+// and all responses for a service are invalidated by any mutating request to that service.
+// A nil *Cache is valid and caches nothing.
+type Cache struct {
+	mu          sync.Mutex
+	generations map[string]uint64
+	responses   map[string]map[string]*response
+}
+
+// New returns an empty Cache.
+func New() *Cache {
+	return &Cache{
+		generations: make(map[string]uint64),
+		responses:   make(map[string]map[string]*response),
+	}
+}
+
+// Invalidate removes all cached responses for a service.
+func (c *Cache) Invalidate(service string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	service = retry.NormalizeServiceID(service)
+
+	c.generations[service]++
+	delete(c.responses, service)
+}
+
+// get returns the cached response, if any, and the current generation of the service's responses.
+func (c *Cache) get(service, key string) (*response, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.responses[service][key], c.generations[service]
+}
+
+// put caches a response unless the service's responses were invalidated since the given generation.
+func (c *Cache) put(service, key string, generation uint64, resp *response) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[service] != generation {
+		return
+	}
+
+	if _, ok := c.responses[service]; !ok {
+		c.responses[service] = make(map[string]*response)
+	}
+
+	c.responses[service][key] = resp
+}
+
+// InvalidateHandler returns a request handler, to be added to the Send and Complete handler lists of
+// a session or service client, that invalidates the cached responses of a service on mutating requests.
+// Invalidating both before and after the request ensures that a concurrent read does not cache a stale response.
+func (c *Cache) InvalidateHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: InvalidateHandlerName,
+		Fn: func(r *request.Request) {
+			if IsReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			c.Invalidate(r.ClientInfo.ServiceID)
+		},
+	}
+}
+
+// Cacheable returns a request.Option that opts a read-only request into the cache.
+// Cached responses are unmarshaled afresh for each request, so callers may modify the output.
+// Returns a no-op option for a nil Cache.
+func (c *Cache) Cacheable() request.Option {
+	return func(r *request.Request) {
+		if c == nil || !IsReadOnlyOperation(r.Operation.Name) {
+			return
+		}
+
+		send := r.Handlers.Copy().Send
+
+		r.Handlers.Send.Clear()
+		r.Handlers.Send.PushBackNamed(request.NamedHandler{
+			Name: CacheableHandlerName,
+			Fn: func(r *request.Request) {
+				c.send(r, send)
+			},
+		})
+	}
+}
+
+func (c *Cache) send(r *request.Request, send request.HandlerList) {
+	service := retry.NormalizeServiceID(r.ClientInfo.ServiceID)
+	key, err := requestKey(r)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to cache %s %s request: %s", r.ClientInfo.ServiceID, r.Operation.Name, err)
+		send.Run(r)
+		return
+	}
+
+	resp, generation := c.get(service, key)
+
+	if resp != nil {
+		log.Printf("[DEBUG] Using cached %s %s response", r.ClientInfo.ServiceID, r.Operation.Name)
+		r.HTTPResponse = &http.Response{
+			Status:        http.StatusText(resp.statusCode),
+			StatusCode:    resp.statusCode,
+			Header:        resp.header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader(resp.body)),
+			ContentLength: int64(len(resp.body)),
+		}
+		return
+	}
+
+	send.Run(r)
+
+	if r.Error != nil || r.HTTPResponse == nil || r.HTTPResponse.StatusCode < 200 || r.HTTPResponse.StatusCode > 299 {
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.put(service, key, generation, &response{
+		body:       body,
+		header:     r.HTTPResponse.Header.Clone(),
+		statusCode: r.HTTPResponse.StatusCode,
+	})
+}
+
+// requestKey returns the cache key for a built request.
+// The serialized request is used as the normalized form of the operation input.
+func requestKey(r *request.Request) (string, error) {
+	var body []byte
+
+	if r.Body != nil {
+		if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+			return "", err
+		}
+
+		v, err := ioutil.ReadAll(r.Body)
+
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := r.Body.Seek(r.BodyStart, io.SeekStart); err != nil {
+			return "", err
+		}
+
+		body = v
+	}
+
+	return strings.Join([]string{
+		r.ClientInfo.SigningRegion,
+		r.Operation.Name,
+		r.HTTPRequest.Method,
+		r.HTTPRequest.URL.String(),
+		r.HTTPRequest.Header.Get("X-Amz-Target"),
+		string(body),
+	}, "\n"), nil
+}
@@ -0,0 +1,130 @@
+package requestcache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		Operation string
+		Expected  bool
+	}{
+		{Operation: "DescribeSecurityGroups", Expected: true},
+		{Operation: "ListAttachedRolePolicies", Expected: true},
+		{Operation: "GetRole", Expected: true},
+		{Operation: "AuthorizeSecurityGroupIngress", Expected: false},
+		{Operation: "CreateTags", Expected: false},
+		{Operation: "DeleteRole", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Operation, func(t *testing.T) {
+			if got := IsReadOnlyOperation(testCase.Operation); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing request: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch r.Form.Get("Action") {
+		case "DescribeSecurityGroups":
+			fmt.Fprintf(w, `<DescribeSecurityGroupsResponse><requestId>%[1]d</requestId><securityGroupInfo><item><groupId>%[2]s</groupId><ownerId>%[1]d</ownerId></item></securityGroupInfo></DescribeSecurityGroupsResponse>`, n, r.Form.Get("GroupId.1"))
+		case "CreateTags":
+			fmt.Fprintf(w, `<CreateTagsResponse><requestId>%d</requestId><return>true</return></CreateTagsResponse>`, n)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	cache := New()
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-west-2"),
+	}))
+	sess.Handlers.Send.PushFrontNamed(cache.InvalidateHandler())
+	sess.Handlers.Complete.PushFrontNamed(cache.InvalidateHandler())
+	conn := ec2.New(sess)
+
+	describe := func(id string, cacheable bool) string {
+		t.Helper()
+
+		var opts []request.Option
+
+		if cacheable {
+			opts = append(opts, cache.Cacheable())
+		}
+
+		output, err := conn.DescribeSecurityGroupsWithContext(context.Background(), &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{id})}, opts...)
+
+		if err != nil {
+			t.Fatalf("describing security group: %s", err)
+		}
+
+		if got := aws.StringValue(output.SecurityGroups[0].GroupId); got != id {
+			t.Fatalf("got security group %s, expected %s", got, id)
+		}
+
+		return aws.StringValue(output.SecurityGroups[0].OwnerId)
+	}
+
+	first := describe("sg-1", true)
+
+	if got := describe("sg-1", true); got != first {
+		t.Errorf("expected cached response %s, got %s", first, got)
+	}
+
+	if got := describe("sg-2", true); got == first {
+		t.Error("expected different input not to use cached response")
+	}
+
+	if got := describe("sg-1", false); got == first {
+		t.Error("expected request without option not to use cached response")
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("got %d requests, expected 3", got)
+	}
+
+	if _, err := conn.CreateTags(&ec2.CreateTagsInput{Resources: aws.StringSlice([]string{"sg-1"}), Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}}); err != nil {
+		t.Fatalf("creating tags: %s", err)
+	}
+
+	if got := describe("sg-1", true); got == first {
+		t.Error("expected mutating request to invalidate cached response")
+	}
+}
+
+func TestCacheNil(t *testing.T) {
+	var cache *Cache
+
+	cache.Invalidate("ec2")
+
+	if cache.Cacheable() == nil {
+		t.Error("expected no-op option")
+	}
+}
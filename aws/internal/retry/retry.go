@@ -0,0 +1,203 @@
+package retry
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// RetryHandlerName is the name of the request handler added to AWS service clients.
+const RetryHandlerName = "terraform-provider-aws.retry.RegistryRetryHandler"
+
+// Rule describes an AWS API error condition that should be retried.
+type Rule struct {
+	// Service is the normalized service identifier, e.g. ec2 or cloudwatchlogs.
+	// See NormalizeServiceID.
+	Service string
+
+	// Operations limits the rule to the named API operations.
+	// A trailing * matches operation names by prefix, e.g. Describe*.
+	// The rule matches all operations if empty.
+	Operations []string
+
+	// ErrorCode is the AWS error code to match.
+	ErrorCode string
+
+	// ErrorMessage is an optional substring of the AWS error message to match.
+	ErrorMessage string
+
+	// MaxRetries limits the number of retries for the matched error.
+	// The service client maximum applies if zero.
+	MaxRetries int
+
+	// Backoff is a fixed delay between retries for the matched error.
+	// The service client exponential backoff applies if zero.
+	Backoff time.Duration
+}
+
+// Matches returns whether the rule matches the given operation and error.
+func (rule *Rule) Matches(operation string, err error) bool {
+	if !tfawserr.ErrMessageContains(err, rule.ErrorCode, rule.ErrorMessage) {
+		return false
+	}
+
+	if len(rule.Operations) == 0 {
+		return true
+	}
+
+	for _, o := range rule.Operations {
+		if prefix := strings.TrimSuffix(o, "*"); prefix != o {
+			if strings.HasPrefix(operation, prefix) {
+				return true
+			}
+		} else if o == operation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Registry holds retry rules and per-service maximum retries.
+// Rules registered later take precedence over rules registered earlier.
+type Registry struct {
+	mu         sync.RWMutex
+	maxRetries map[string]int
+	rules      map[string][]*Rule
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		maxRetries: make(map[string]int),
+		rules:      make(map[string][]*Rule),
+	}
+}
+
+// Register adds rules to the registry.
+func (r *Registry) Register(rules ...*Rule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rule := range rules {
+		service := NormalizeServiceID(rule.Service)
+		r.rules[service] = append(r.rules[service], rule)
+	}
+}
+
+// SetMaxRetries overrides the service client maximum retries for a service.
+func (r *Registry) SetMaxRetries(service string, maxRetries int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.maxRetries[NormalizeServiceID(service)] = maxRetries
+}
+
+// MaxRetries returns the maximum retries configured for a service, if any.
+func (r *Registry) MaxRetries(service string) (int, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.maxRetries[NormalizeServiceID(service)]
+
+	return v, ok
+}
+
+// Match returns the most recently registered rule matching the service, operation and error.
+// Returns nil if no rule matches.
+func (r *Registry) Match(service, operation string, err error) *Rule {
+	if err == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rules := r.rules[NormalizeServiceID(service)]
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].Matches(operation, err) {
+			return rules[i]
+		}
+	}
+
+	return nil
+}
+
+// RetryHandler returns a request handler, to be added to the Retry handler list of
+// a session or service client, that applies the registry to failed requests.
+func (r *Registry) RetryHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: RetryHandlerName,
+		Fn:   r.retry,
+	}
+}
+
+func (r *Registry) retry(req *request.Request) {
+	service := NormalizeServiceID(req.ClientInfo.ServiceID)
+	retryer := newRetryer(req.Retryer)
+	retryer.backoff = 0
+
+	if v, ok := r.MaxRetries(service); ok {
+		retryer.maxRetries = v
+	}
+
+	if rule := r.Match(service, req.Operation.Name, req.Error); rule != nil {
+		if rule.MaxRetries > 0 && req.RetryCount >= rule.MaxRetries {
+			req.Retryable = aws.Bool(false)
+		} else {
+			req.Retryable = aws.Bool(true)
+		}
+
+		if rule.Backoff > 0 {
+			retryer.backoff = rule.Backoff
+		}
+	}
+
+	req.Retryer = retryer
+}
+
+// NormalizeServiceID returns the service identifier used by the registry,
+// the AWS SDK service ID lowercased with spaces removed, e.g. "CloudWatch Logs" becomes cloudwatchlogs.
+func NormalizeServiceID(serviceID string) string {
+	return strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))
+}
+
+// retryer wraps a request.Retryer, optionally overriding its maximum retries and backoff.
+type retryer struct {
+	request.Retryer
+
+	backoff    time.Duration
+	maxRetries int
+}
+
+func newRetryer(r request.Retryer) *retryer {
+	if v, ok := r.(*retryer); ok {
+		return v
+	}
+
+	return &retryer{
+		Retryer:    r,
+		maxRetries: -1,
+	}
+}
+
+func (r *retryer) MaxRetries() int {
+	if r.maxRetries >= 0 {
+		return r.maxRetries
+	}
+
+	return r.Retryer.MaxRetries()
+}
+
+func (r *retryer) RetryRules(req *request.Request) time.Duration {
+	if r.backoff > 0 {
+		return r.backoff
+	}
+
+	return r.Retryer.RetryRules(req)
+}
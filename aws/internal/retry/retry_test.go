@@ -0,0 +1,227 @@
+package retry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRuleMatches(t *testing.T) {
+	testCases := []struct {
+		TestName  string
+		Rule      *Rule
+		Operation string
+		Err       error
+		Expected  bool
+	}{
+		{
+			TestName:  "nil error",
+			Rule:      &Rule{ErrorCode: "ThrottlingException"},
+			Operation: "ListTagsForResource",
+			Err:       nil,
+			Expected:  false,
+		},
+		{
+			TestName:  "non-AWS error",
+			Rule:      &Rule{ErrorCode: "ThrottlingException"},
+			Operation: "ListTagsForResource",
+			Err:       errors.New("ThrottlingException"),
+			Expected:  false,
+		},
+		{
+			TestName:  "error code",
+			Rule:      &Rule{ErrorCode: "ThrottlingException"},
+			Operation: "ListTagsForResource",
+			Err:       awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected:  true,
+		},
+		{
+			TestName:  "different error code",
+			Rule:      &Rule{ErrorCode: "ThrottlingException"},
+			Operation: "ListTagsForResource",
+			Err:       awserr.New("AccessDeniedException", "Rate exceeded", nil),
+			Expected:  false,
+		},
+		{
+			TestName:  "error message",
+			Rule:      &Rule{ErrorCode: "ThrottlingException", ErrorMessage: "Rate exceeded"},
+			Operation: "ListTagsForResource",
+			Err:       awserr.New("ThrottlingException", "Rate exceeded for account", nil),
+			Expected:  true,
+		},
+		{
+			TestName:  "different error message",
+			Rule:      &Rule{ErrorCode: "ThrottlingException", ErrorMessage: "Rate exceeded"},
+			Operation: "ListTagsForResource",
+			Err:       awserr.New("ThrottlingException", "Too many requests", nil),
+			Expected:  false,
+		},
+		{
+			TestName:  "operation",
+			Rule:      &Rule{Operations: []string{"CreateStream", "DeleteStream"}, ErrorCode: "LimitExceededException"},
+			Operation: "DeleteStream",
+			Err:       awserr.New("LimitExceededException", "", nil),
+			Expected:  true,
+		},
+		{
+			TestName:  "different operation",
+			Rule:      &Rule{Operations: []string{"CreateStream", "DeleteStream"}, ErrorCode: "LimitExceededException"},
+			Operation: "DescribeStream",
+			Err:       awserr.New("LimitExceededException", "", nil),
+			Expected:  false,
+		},
+		{
+			TestName:  "operation prefix",
+			Rule:      &Rule{Operations: []string{"Describe*"}, ErrorCode: "FailedResourceAccessException"},
+			Operation: "DescribeScalingPolicies",
+			Err:       awserr.New("FailedResourceAccessException", "", nil),
+			Expected:  true,
+		},
+		{
+			TestName:  "different operation prefix",
+			Rule:      &Rule{Operations: []string{"Describe*"}, ErrorCode: "FailedResourceAccessException"},
+			Operation: "PutScalingPolicy",
+			Err:       awserr.New("FailedResourceAccessException", "", nil),
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := testCase.Rule.Matches(testCase.Operation, testCase.Err)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRegistryMatch(t *testing.T) {
+	first := &Rule{Service: "route53", ErrorCode: "PriorRequestNotComplete"}
+	second := &Rule{Service: "Route 53", ErrorCode: "PriorRequestNotComplete", MaxRetries: 5}
+
+	registry := NewRegistry()
+	registry.Register(first, second)
+
+	if got := registry.Match("ec2", "ChangeResourceRecordSets", awserr.New("PriorRequestNotComplete", "", nil)); got != nil {
+		t.Errorf("expected no match for different service, got %v", got)
+	}
+
+	if got := registry.Match("route53", "ChangeResourceRecordSets", awserr.New("PriorRequestNotComplete", "", nil)); got != second {
+		t.Errorf("expected most recently registered rule, got %v", got)
+	}
+}
+
+func TestRegistryRetryHandler(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		Rules              []*Rule
+		ServiceMaxRetries  map[string]int
+		RetryCount         int
+		Err                error
+		ExpectedRetryable  *bool
+		ExpectedMaxRetries int
+		ExpectedDelay      time.Duration
+	}{
+		{
+			TestName:           "no rules",
+			Err:                awserr.New("ValidationException", "", nil),
+			ExpectedRetryable:  nil,
+			ExpectedMaxRetries: 3,
+		},
+		{
+			TestName:           "service max retries",
+			ServiceMaxRetries:  map[string]int{"cloudwatchlogs": 10},
+			Err:                awserr.New("ValidationException", "", nil),
+			ExpectedRetryable:  nil,
+			ExpectedMaxRetries: 10,
+		},
+		{
+			TestName:           "matching rule",
+			Rules:              []*Rule{{Service: "cloudwatchlogs", ErrorCode: "OperationAbortedException"}},
+			Err:                awserr.New("OperationAbortedException", "", nil),
+			ExpectedRetryable:  aws.Bool(true),
+			ExpectedMaxRetries: 3,
+		},
+		{
+			TestName:           "matching rule other service",
+			Rules:              []*Rule{{Service: "iam", ErrorCode: "OperationAbortedException"}},
+			Err:                awserr.New("OperationAbortedException", "", nil),
+			ExpectedRetryable:  nil,
+			ExpectedMaxRetries: 3,
+		},
+		{
+			TestName:           "matching rule max retries exceeded",
+			Rules:              []*Rule{{Service: "cloudwatchlogs", ErrorCode: "OperationAbortedException", MaxRetries: 2}},
+			RetryCount:         2,
+			Err:                awserr.New("OperationAbortedException", "", nil),
+			ExpectedRetryable:  aws.Bool(false),
+			ExpectedMaxRetries: 3,
+		},
+		{
+			TestName:           "matching rule backoff",
+			Rules:              []*Rule{{Service: "cloudwatchlogs", ErrorCode: "OperationAbortedException", Backoff: 5 * time.Second}},
+			Err:                awserr.New("OperationAbortedException", "", nil),
+			ExpectedRetryable:  aws.Bool(true),
+			ExpectedMaxRetries: 3,
+			ExpectedDelay:      5 * time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			registry := NewRegistry()
+			registry.Register(testCase.Rules...)
+
+			for service, maxRetries := range testCase.ServiceMaxRetries {
+				registry.SetMaxRetries(service, maxRetries)
+			}
+
+			req := &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: "CloudWatch Logs"},
+				Operation:  &request.Operation{Name: "PutRetentionPolicy"},
+				Retryer:    client.DefaultRetryer{NumMaxRetries: 3},
+				RetryCount: testCase.RetryCount,
+				Error:      testCase.Err,
+			}
+
+			registry.RetryHandler().Fn(req)
+
+			if got, expected := req.Retryable, testCase.ExpectedRetryable; (got == nil) != (expected == nil) || (got != nil && *got != *expected) {
+				t.Errorf("got Retryable %v, expected %v", aws.BoolValue(got), aws.BoolValue(expected))
+			}
+
+			if got, expected := req.MaxRetries(), testCase.ExpectedMaxRetries; got != expected {
+				t.Errorf("got MaxRetries %d, expected %d", got, expected)
+			}
+
+			if expected := testCase.ExpectedDelay; expected > 0 {
+				if got := req.RetryRules(req); got != expected {
+					t.Errorf("got retry delay %s, expected %s", got, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeServiceID(t *testing.T) {
+	testCases := map[string]string{
+		"CloudWatch Logs": "cloudwatchlogs",
+		"Route 53":        "route53",
+		"IAM":             "iam",
+		"ec2":             "ec2",
+	}
+
+	for serviceID, expected := range testCases {
+		if got := NormalizeServiceID(serviceID); got != expected {
+			t.Errorf("NormalizeServiceID(%q) = %q, expected %q", serviceID, got, expected)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// CachePolicyByID retrieves a CloudFront Cache Policy, along with its ETag, by id.
+func CachePolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetCachePolicyOutput, error) {
+	input := &cloudfront.GetCachePolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCachePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchCachePolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CachePolicy == nil || output.CachePolicy.CachePolicyConfig == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// CachePolicyByName retrieves a CloudFront Cache Policy by name.
+// Both AWS managed and custom policies are searched.
+func CachePolicyByName(conn *cloudfront.CloudFront, name string) (*cloudfront.CachePolicy, error) {
+	input := &cloudfront.ListCachePoliciesInput{}
+
+	for {
+		output, err := conn.ListCachePolicies(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.CachePolicyList == nil {
+			break
+		}
+
+		for _, item := range output.CachePolicyList.Items {
+			if item == nil || item.CachePolicy == nil || item.CachePolicy.CachePolicyConfig == nil {
+				continue
+			}
+
+			if aws.StringValue(item.CachePolicy.CachePolicyConfig.Name) == name {
+				return item.CachePolicy, nil
+			}
+		}
+
+		if aws.StringValue(output.CachePolicyList.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.CachePolicyList.NextMarker
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
+
+// OriginRequestPolicyByID retrieves a CloudFront Origin Request Policy, along with its ETag, by id.
+func OriginRequestPolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetOriginRequestPolicyOutput, error) {
+	input := &cloudfront.GetOriginRequestPolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetOriginRequestPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OriginRequestPolicy == nil || output.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// OriginRequestPolicyByName retrieves a CloudFront Origin Request Policy by name.
+// Both AWS managed and custom policies are searched.
+func OriginRequestPolicyByName(conn *cloudfront.CloudFront, name string) (*cloudfront.OriginRequestPolicy, error) {
+	input := &cloudfront.ListOriginRequestPoliciesInput{}
+
+	for {
+		output, err := conn.ListOriginRequestPolicies(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.OriginRequestPolicyList == nil {
+			break
+		}
+
+		for _, item := range output.OriginRequestPolicyList.Items {
+			if item == nil || item.OriginRequestPolicy == nil || item.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+				continue
+			}
+
+			if aws.StringValue(item.OriginRequestPolicy.OriginRequestPolicyConfig.Name) == name {
+				return item.OriginRequestPolicy, nil
+			}
+		}
+
+		if aws.StringValue(output.OriginRequestPolicyList.NextMarker) == "" {
+			break
+		}
+
+		input.Marker = output.OriginRequestPolicyList.NextMarker
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     "Empty result",
+		LastRequest: input,
+	}
+}
+
+// RealtimeLogConfigByARN retrieves a CloudFront Real-time Log Configuration by ARN.
+func RealtimeLogConfigByARN(conn *cloudfront.CloudFront, arn string) (*cloudfront.RealtimeLogConfig, error) {
+	input := &cloudfront.GetRealtimeLogConfigInput{
+		ARN: aws.String(arn),
+	}
+
+	return RealtimeLogConfig(conn, input)
+}
+
+// RealtimeLogConfigByName retrieves a CloudFront Real-time Log Configuration by name.
+func RealtimeLogConfigByName(conn *cloudfront.CloudFront, name string) (*cloudfront.RealtimeLogConfig, error) {
+	input := &cloudfront.GetRealtimeLogConfigInput{
+		Name: aws.String(name),
+	}
+
+	return RealtimeLogConfig(conn, input)
+}
+
+// RealtimeLogConfig retrieves a CloudFront Real-time Log Configuration using GetRealtimeLogConfigInput.
+func RealtimeLogConfig(conn *cloudfront.CloudFront, input *cloudfront.GetRealtimeLogConfigInput) (*cloudfront.RealtimeLogConfig, error) {
+	output, err := conn.GetRealtimeLogConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchRealtimeLogConfig) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RealtimeLogConfig == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.RealtimeLogConfig, nil
+}
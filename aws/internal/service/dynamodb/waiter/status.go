@@ -0,0 +1,54 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ContributorInsightsStatus fetches the Contributor Insights settings and their status
+func ContributorInsightsStatus(conn *dynamodb.DynamoDB, tableName, indexName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ContributorInsights(conn, tableName, indexName)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ContributorInsightsStatus), nil
+	}
+}
+
+// KinesisStreamingDestinationStatus fetches the Kinesis streaming destination and its status
+func KinesisStreamingDestinationStatus(conn *dynamodb.DynamoDB, tableName, streamArn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		destination, err := finder.KinesisStreamingDestination(conn, tableName, streamArn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return destination, aws.StringValue(destination.DestinationStatus), nil
+	}
+}
+
+// TableExportStatus fetches the table export and its status
+func TableExportStatus(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		export, err := finder.TableExportByARN(conn, arn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		return export, aws.StringValue(export.ExportStatus), nil
+	}
+}
@@ -0,0 +1,126 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ContributorInsightsEnabledTimeout  = 5 * time.Minute
+	ContributorInsightsDisabledTimeout = 5 * time.Minute
+
+	KinesisStreamingDestinationActiveTimeout   = 5 * time.Minute
+	KinesisStreamingDestinationDisabledTimeout = 5 * time.Minute
+
+	TableExportCompletedTimeout = 60 * time.Minute
+
+	tableExportCompletedMinTimeout = 10 * time.Second
+	tableExportCompletedDelay      = 30 * time.Second
+)
+
+// ContributorInsightsEnabled waits for Contributor Insights to return Enabled
+func ContributorInsightsEnabled(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.ContributorInsightsStatusEnabling},
+		Target:  []string{dynamodb.ContributorInsightsStatusEnabled},
+		Refresh: ContributorInsightsStatus(conn, tableName, indexName),
+		Timeout: ContributorInsightsEnabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		return v, contributorInsightsFailureError(v, err)
+	}
+	return nil, err
+}
+
+// ContributorInsightsDisabled waits for Contributor Insights to return Disabled
+func ContributorInsightsDisabled(conn *dynamodb.DynamoDB, tableName, indexName string) (*dynamodb.DescribeContributorInsightsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.ContributorInsightsStatusEnabled,
+			dynamodb.ContributorInsightsStatusDisabling,
+		},
+		Target:  []string{dynamodb.ContributorInsightsStatusDisabled},
+		Refresh: ContributorInsightsStatus(conn, tableName, indexName),
+		Timeout: ContributorInsightsDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		return v, contributorInsightsFailureError(v, err)
+	}
+	return nil, err
+}
+
+// KinesisStreamingDestinationActive waits for a Kinesis streaming destination to return Active
+func KinesisStreamingDestinationActive(conn *dynamodb.DynamoDB, tableName, streamArn string) (*dynamodb.KinesisDataStreamDestination, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dynamodb.DestinationStatusEnabling},
+		Target:  []string{dynamodb.DestinationStatusActive},
+		Refresh: KinesisStreamingDestinationStatus(conn, tableName, streamArn),
+		Timeout: KinesisStreamingDestinationActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*dynamodb.KinesisDataStreamDestination); ok {
+		if err != nil && aws.StringValue(v.DestinationStatusDescription) != "" {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.DestinationStatusDescription), err)
+		}
+		return v, err
+	}
+	return nil, err
+}
+
+// KinesisStreamingDestinationDisabled waits for a Kinesis streaming destination to return Disabled
+func KinesisStreamingDestinationDisabled(conn *dynamodb.DynamoDB, tableName, streamArn string) (*dynamodb.KinesisDataStreamDestination, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.DestinationStatusActive,
+			dynamodb.DestinationStatusDisabling,
+		},
+		Target:  []string{dynamodb.DestinationStatusDisabled},
+		Refresh: KinesisStreamingDestinationStatus(conn, tableName, streamArn),
+		Timeout: KinesisStreamingDestinationDisabledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*dynamodb.KinesisDataStreamDestination); ok {
+		return v, err
+	}
+	return nil, err
+}
+
+// TableExportCompleted waits for a table export to return Completed
+func TableExportCompleted(conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.ExportStatusInProgress},
+		Target:     []string{dynamodb.ExportStatusCompleted},
+		Refresh:    TableExportStatus(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: tableExportCompletedMinTimeout,
+		Delay:      tableExportCompletedDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if v, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if err != nil && v.FailureCode != nil {
+			err = fmt.Errorf("%s: %s: %w", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureMessage), err)
+		}
+		return v, err
+	}
+	return nil, err
+}
+
+// contributorInsightsFailureError adds the reported failure, if any, to err.
+func contributorInsightsFailureError(output *dynamodb.DescribeContributorInsightsOutput, err error) error {
+	if err == nil || output.FailureException == nil {
+		return err
+	}
+
+	return fmt.Errorf("%s: %s: %w", aws.StringValue(output.FailureException.ExceptionName), aws.StringValue(output.FailureException.ExceptionDescription), err)
+}
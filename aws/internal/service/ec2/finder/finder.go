@@ -2,6 +2,7 @@ package finder
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
@@ -75,11 +76,12 @@ func ClientVpnRouteByID(conn *ec2.EC2, routeID string) (*ec2.DescribeClientVpnRo
 }
 
 // SecurityGroupByID looks up a security group by ID. When not found, returns nil and potentially an API error.
-func SecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+// Request options, such as opting into the provider request cache, are applied to the API request.
+func SecurityGroupByID(conn *ec2.EC2, id string, optFns ...request.Option) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: aws.StringSlice([]string{id}),
 	}
-	result, err := conn.DescribeSecurityGroups(req)
+	result, err := conn.DescribeSecurityGroupsWithContext(aws.BackgroundContext(), req, optFns...)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package waiter
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -34,3 +36,75 @@ func CapacityProviderStatus(conn *ecs.ECS, capacityProvider string) resource.Sta
 		return output.CapacityProviders[0], aws.StringValue(output.CapacityProviders[0].Status), nil
 	}
 }
+
+const (
+	// Status of the current deployment of a Service
+	ServiceDeploymentPrimary = "PRIMARY"
+
+	// Service deployment has not reached a steady state
+	ServiceDeploymentStatusPending = "Pending"
+
+	// Service deployment is the only deployment and all its tasks are running
+	ServiceDeploymentStatusStable = "Stable"
+)
+
+// ServiceDeploymentStatus fetches the Service and reports whether the given
+// deployment has reached a steady state. It returns an error once the
+// deployment has failed or has been replaced, e.g. rolled back by the
+// deployment circuit breaker.
+func ServiceDeploymentStatus(conn *ecs.ECS, cluster, service, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeServicesInput{
+			Services: aws.StringSlice([]string{service}),
+		}
+
+		if cluster != "" {
+			input.Cluster = aws.String(cluster)
+		}
+
+		output, err := conn.DescribeServices(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output.Services) == 0 || output.Services[0] == nil {
+			return nil, "", fmt.Errorf("service not found")
+		}
+
+		s := output.Services[0]
+
+		if status := aws.StringValue(s.Status); status != "ACTIVE" {
+			return nil, "", fmt.Errorf("service status is %s", status)
+		}
+
+		var deployment *ecs.Deployment
+
+		for _, d := range s.Deployments {
+			if aws.StringValue(d.Id) == deploymentID {
+				deployment = d
+				break
+			}
+		}
+
+		rollback := s.DeploymentConfiguration != nil && s.DeploymentConfiguration.DeploymentCircuitBreaker != nil && aws.BoolValue(s.DeploymentConfiguration.DeploymentCircuitBreaker.Rollback)
+
+		if deployment != nil && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			if rollback {
+				return s, "", fmt.Errorf("deployment (%s) failed and was rolled back by the deployment circuit breaker: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason))
+			}
+
+			return s, "", fmt.Errorf("deployment (%s) failed: %s", deploymentID, aws.StringValue(deployment.RolloutStateReason))
+		}
+
+		if deployment == nil || aws.StringValue(deployment.Status) != ServiceDeploymentPrimary {
+			return s, "", fmt.Errorf("deployment (%s) is no longer the primary deployment", deploymentID)
+		}
+
+		if len(s.Deployments) == 1 && aws.Int64Value(s.RunningCount) == aws.Int64Value(s.DesiredCount) {
+			return s, ServiceDeploymentStatusStable, nil
+		}
+
+		return s, ServiceDeploymentStatusPending, nil
+	}
+}
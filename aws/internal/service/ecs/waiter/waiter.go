@@ -10,6 +10,11 @@ import (
 const (
 	// Maximum amount of time to wait for a Capacity Provider to return INACTIVE
 	CapacityProviderInactiveTimeout = 20 * time.Minute
+
+	// Maximum amount of time to wait for a Service deployment to reach a steady state
+	ServiceStableTimeout = 10 * time.Minute
+
+	serviceStablePollInterval = 15 * time.Second
 )
 
 // CapacityProviderInactive waits for a Capacity Provider to return INACTIVE
@@ -29,3 +34,22 @@ func CapacityProviderInactive(conn *ecs.ECS, capacityProvider string) (*ecs.Capa
 
 	return nil, err
 }
+
+// ServiceStable waits for a Service deployment to reach a steady state
+func ServiceStable(conn *ecs.ECS, cluster, service, deploymentID string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{ServiceDeploymentStatusPending},
+		Target:       []string{ServiceDeploymentStatusStable},
+		Refresh:      ServiceDeploymentStatus(conn, cluster, service, deploymentID),
+		Timeout:      timeout,
+		PollInterval: serviceStablePollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,173 @@
+//go:generate go run generator/main.go
+
+// Package catalog contains a catalog of IAM service prefixes, actions, resource ARN formats and condition keys,
+// used to validate IAM policy documents without calling AWS.
+//
+// The catalog is not exhaustive: services which are not in the catalog should not be validated against it.
+// To update the catalog, edit catalog.json, bump its Version and run go generate.
+package catalog
+
+import (
+	"strings"
+)
+
+// Service is the IAM authorization information of an AWS service.
+type Service struct {
+	// Prefix is the service prefix used in actions, e.g. s3.
+	Prefix string
+
+	// Name is the name of the service.
+	Name string
+
+	// Actions are the action names, without service prefix.
+	Actions []string
+
+	// ResourceARNFormats are the ARN formats of the service resource types.
+	// Variables in ARN formats are written as ${Name}.
+	ResourceARNFormats []string
+
+	// ConditionKeys are the service specific condition keys.
+	// Variables in condition keys are written as ${Name}.
+	ConditionKeys []string
+}
+
+// LookupService returns the catalog entry for a service prefix.
+func LookupService(prefix string) (*Service, bool) {
+	service, ok := services[strings.ToLower(prefix)]
+
+	return service, ok
+}
+
+// IsGlobalConditionKey returns whether a condition key is a global (aws:) condition key.
+func IsGlobalConditionKey(key string) bool {
+	return matchesAnyPattern(key, globalConditionKeys)
+}
+
+// MatchActions returns the service actions matched by an action name pattern, without service prefix.
+// Action names are case insensitive.
+func (s *Service) MatchActions(pattern string) []string {
+	var actions []string
+
+	for _, action := range s.Actions {
+		if Intersects(strings.ToLower(pattern), strings.ToLower(action)) {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// MatchesResourceARN returns whether a resource ARN pattern can match any of the service resource ARN formats.
+// If the service has no resource ARN formats in the catalog, true is returned.
+func (s *Service) MatchesResourceARN(pattern string) bool {
+	if len(s.ResourceARNFormats) == 0 {
+		return true
+	}
+
+	for _, format := range s.ResourceARNFormats {
+		if Intersects(pattern, format) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasConditionKey returns whether a condition key is a service specific condition key.
+// If the service has no condition keys in the catalog, true is returned.
+func (s *Service) HasConditionKey(key string) bool {
+	if len(s.ConditionKeys) == 0 {
+		return true
+	}
+
+	return matchesAnyPattern(key, s.ConditionKeys)
+}
+
+// matchesAnyPattern returns whether a condition key matches any of the key patterns. Condition keys are case insensitive.
+func matchesAnyPattern(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if Intersects(strings.ToLower(key), strings.ToLower(pattern)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type token struct {
+	// wildcard is '*' for any sequence of characters, '?' for any single character, or 0 for the literal c.
+	wildcard byte
+	c        byte
+}
+
+// tokenize splits an IAM pattern into tokens. Variables such as ${aws:username} (policy variables)
+// or ${BucketName} (catalog variables) are treated as matching any sequence of characters.
+func tokenize(pattern string) []token {
+	var tokens []token
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*', '?':
+			tokens = append(tokens, token{wildcard: c})
+		case '$', '&':
+			if i+1 < len(pattern) && pattern[i+1] == '{' {
+				if end := strings.IndexByte(pattern[i:], '}'); end > 0 {
+					tokens = append(tokens, token{wildcard: '*'})
+					i += end
+					continue
+				}
+			}
+
+			tokens = append(tokens, token{c: c})
+		default:
+			tokens = append(tokens, token{c: c})
+		}
+	}
+
+	return tokens
+}
+
+// Intersects returns whether there is any string matched by both IAM patterns a and b.
+// Patterns may contain the * and ? wildcards and ${...} variables.
+func Intersects(a, b string) bool {
+	x, y := tokenize(a), tokenize(b)
+	seen := make(map[[2]int]bool)
+
+	var intersects func(i, j int) bool
+	intersects = func(i, j int) bool {
+		if i == len(x) && j == len(y) {
+			return true
+		}
+
+		key := [2]int{i, j}
+
+		if seen[key] {
+			return false
+		}
+
+		seen[key] = true
+
+		if i < len(x) && x[i].wildcard == '*' {
+			// Match the empty string or consume the next token of the other pattern.
+			if intersects(i+1, j) || (j < len(y) && intersects(i, j+1)) {
+				return true
+			}
+		}
+
+		if j < len(y) && y[j].wildcard == '*' {
+			if intersects(i, j+1) || (i < len(x) && intersects(i+1, j)) {
+				return true
+			}
+		}
+
+		if i < len(x) && j < len(y) && x[i].wildcard != '*' && y[j].wildcard != '*' {
+			if x[i].wildcard == '?' || y[j].wildcard == '?' || x[i].c == y[j].c {
+				return intersects(i+1, j+1)
+			}
+		}
+
+		return false
+	}
+
+	return intersects(0, 0)
+}
@@ -0,0 +1,108 @@
+package catalog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntersects(t *testing.T) {
+	testCases := []struct {
+		A        string
+		B        string
+		Expected bool
+	}{
+		{A: "getobject", B: "getobject", Expected: true},
+		{A: "getobjects", B: "getobject", Expected: false},
+		{A: "get*", B: "getobject", Expected: true},
+		{A: "*object", B: "getobject", Expected: true},
+		{A: "get?bject", B: "getobject", Expected: true},
+		{A: "list*x", B: "listbucket", Expected: false},
+		{A: "arn:aws:s3:::my-bucket", B: "arn:${Partition}:s3:::${BucketName}", Expected: true},
+		{A: "arn:aws:s3:::my-bucket/*", B: "arn:${Partition}:s3:::${BucketName}", Expected: true},
+		{A: "arn:aws:s3::my-bucket", B: "arn:${Partition}:s3:::${BucketName}", Expected: false},
+		{A: "arn:aws:s3:*:*:my-bucket", B: "arn:${Partition}:s3:::${BucketName}", Expected: true},
+		{A: "arn:aws:sqs:us-west-2:123456789012:${aws:username}-*", B: "arn:${Partition}:sqs:${Region}:${Account}:${QueueName}", Expected: true},
+		{A: "arn:aws:sqs:us-west-2:123456789012:&{aws:username}", B: "arn:${Partition}:sqs:${Region}:${Account}:${QueueName}", Expected: true},
+		{A: "a$b", B: "a$b", Expected: true},
+		{A: "", B: "*", Expected: true},
+		{A: "", B: "?", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := Intersects(testCase.A, testCase.B); got != testCase.Expected {
+			t.Errorf("Intersects(%q, %q) = %t, expected %t", testCase.A, testCase.B, got, testCase.Expected)
+		}
+
+		if got := Intersects(testCase.B, testCase.A); got != testCase.Expected {
+			t.Errorf("Intersects(%q, %q) = %t, expected %t", testCase.B, testCase.A, got, testCase.Expected)
+		}
+	}
+}
+
+func TestServiceMatchActions(t *testing.T) {
+	service, ok := LookupService("SQS")
+
+	if !ok {
+		t.Fatal("expected sqs service in catalog")
+	}
+
+	if got, expected := service.MatchActions("sendmessage*"), []string{"SendMessage", "SendMessageBatch"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if got := service.MatchActions("SendMessages"); len(got) != 0 {
+		t.Errorf("got %v, expected no actions", got)
+	}
+}
+
+func TestServiceMatchesResourceARN(t *testing.T) {
+	service, ok := LookupService("s3")
+
+	if !ok {
+		t.Fatal("expected s3 service in catalog")
+	}
+
+	for pattern, expected := range map[string]bool{
+		"arn:aws:s3:::my-bucket":                                   true,
+		"arn:aws:s3:::my-bucket/*":                                 true,
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/test":       true,
+		"arn:aws:s3:us-west-2:123456789012:bucket/my-bucket":       false,
+		"arn:aws:s3::123456789012:my-bucket":                       false,
+		"arn:aws-us-gov:s3:::my-bucket/${aws:username}/*":          true,
+		"arn:aws:s3:us-west-2:123456789012:storage-lens/default-*": true,
+	} {
+		if got := service.MatchesResourceARN(pattern); got != expected {
+			t.Errorf("MatchesResourceARN(%q) = %t, expected %t", pattern, got, expected)
+		}
+	}
+}
+
+func TestConditionKeys(t *testing.T) {
+	if !IsGlobalConditionKey("aws:PrincipalTag/team") {
+		t.Error("expected aws:PrincipalTag/team to be a global condition key")
+	}
+
+	if !IsGlobalConditionKey("AWS:SourceVpce") {
+		t.Error("expected condition keys to be case insensitive")
+	}
+
+	if IsGlobalConditionKey("aws:SourceVPCEndpoint") {
+		t.Error("expected aws:SourceVPCEndpoint not to be a global condition key")
+	}
+
+	service, _ := LookupService("s3")
+
+	if !service.HasConditionKey("s3:prefix") {
+		t.Error("expected s3:prefix to be an s3 condition key")
+	}
+
+	if service.HasConditionKey("s3:prefixes") {
+		t.Error("expected s3:prefixes not to be an s3 condition key")
+	}
+
+	service, _ = LookupService("sqs")
+
+	if !service.HasConditionKey("sqs:anything") {
+		t.Error("expected services without catalog condition keys to accept any key")
+	}
+}
@@ -0,0 +1,173 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	catalogFilename = `catalog.json`
+	filename        = `catalog_gen.go`
+)
+
+type Catalog struct {
+	Version             string
+	GlobalConditionKeys []string
+	Services            []Service
+}
+
+type Service struct {
+	Prefix             string
+	Name               string
+	Actions            []string
+	ResourceARNFormats []string
+	ConditionKeys      []string
+}
+
+func main() {
+	b, err := ioutil.ReadFile(catalogFilename)
+
+	if err != nil {
+		log.Fatalf("error reading %s: %s", catalogFilename, err)
+	}
+
+	var catalog Catalog
+
+	if err := json.Unmarshal(b, &catalog); err != nil {
+		log.Fatalf("error parsing %s: %s", catalogFilename, err)
+	}
+
+	if err := validate(catalog); err != nil {
+		log.Fatalf("error validating %s: %s", catalogFilename, err)
+	}
+
+	sort.Slice(catalog.Services, func(i, j int) bool {
+		return catalog.Services[i].Prefix < catalog.Services[j].Prefix
+	})
+
+	tmpl, err := template.New("catalog").Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, catalog)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+func validate(catalog Catalog) error {
+	if catalog.Version == "" {
+		return fmt.Errorf("missing Version")
+	}
+
+	prefixes := make(map[string]bool)
+
+	for _, service := range catalog.Services {
+		if service.Prefix == "" || service.Prefix != strings.ToLower(service.Prefix) {
+			return fmt.Errorf("invalid service prefix: %q", service.Prefix)
+		}
+
+		if prefixes[service.Prefix] {
+			return fmt.Errorf("duplicate service prefix: %s", service.Prefix)
+		}
+
+		prefixes[service.Prefix] = true
+
+		if len(service.Actions) == 0 {
+			return fmt.Errorf("service (%s) has no actions", service.Prefix)
+		}
+
+		for _, action := range service.Actions {
+			if action == "" || strings.ContainsAny(action, ":*?") {
+				return fmt.Errorf("service (%s) has invalid action: %q", service.Prefix, action)
+			}
+		}
+
+		for _, format := range service.ResourceARNFormats {
+			if parts := strings.SplitN(format, ":", 6); len(parts) != 6 || parts[0] != "arn" || parts[2] != service.Prefix {
+				return fmt.Errorf("service (%s) has invalid resource ARN format: %q", service.Prefix, format)
+			}
+		}
+
+		for _, key := range service.ConditionKeys {
+			if !strings.HasPrefix(key, service.Prefix+":") {
+				return fmt.Errorf("service (%s) has invalid condition key: %q", service.Prefix, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+var templateBody = `
+// Code generated by generator/main.go; DO NOT EDIT.
+
+package catalog
+
+// Version is the date the catalog data was last updated.
+const Version = {{ printf "%q" .Version }}
+
+var globalConditionKeys = []string{
+{{- range .GlobalConditionKeys }}
+	{{ printf "%q" . }},
+{{- end }}
+}
+
+var services = map[string]*Service{
+{{- range .Services }}
+	{{ printf "%q" .Prefix }}: {
+		Prefix: {{ printf "%q" .Prefix }},
+		Name:   {{ printf "%q" .Name }},
+		Actions: []string{
+		{{- range .Actions }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+		ResourceARNFormats: []string{
+		{{- range .ResourceARNFormats }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+		ConditionKeys: []string{
+		{{- range .ConditionKeys }}
+			{{ printf "%q" . }},
+		{{- end }}
+		},
+	},
+{{- end }}
+}
+`
@@ -0,0 +1,236 @@
+package sweep
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// referenceSuffixes are the suffixes of attribute names which reference another resource, e.g. vpc_id.
+var referenceSuffixes = []string{"_arns", "_arn", "_ids", "_id", "_names", "_name"}
+
+// referenceAliases maps the last words of reference attribute names which are not named after
+// a resource type, e.g. execution_role_arn, to the resource type they reference.
+var referenceAliases = map[string]string{
+	"bucket":    "aws_s3_bucket",
+	"function":  "aws_lambda_function",
+	"log_group": "aws_cloudwatch_log_group",
+	"queue":     "aws_sqs_queue",
+	"role":      "aws_iam_role",
+	"topic":     "aws_sns_topic",
+}
+
+// Dependencies returns the dependencies of each sweeper, sorted by name.
+// In addition to the declared Dependencies, dependencies are inferred for sweepers named after a resource type:
+// a resource referencing another resource, e.g. through a vpc_id attribute, must be swept before the referenced resource,
+// so the sweeper of the referenced resource depends on the sweeper of the referencing resource.
+// Inferred dependencies which would introduce a dependency cycle are ignored.
+// Declared dependencies on unknown sweepers are ignored.
+func Dependencies(sweepers map[string]*Sweeper, resources map[string]*schema.Resource) map[string][]string {
+	deps := make(map[string]map[string]struct{}, len(sweepers))
+
+	for name, s := range sweepers {
+		deps[name] = make(map[string]struct{})
+
+		for _, dep := range s.Dependencies {
+			if _, ok := sweepers[dep]; ok {
+				deps[name][dep] = struct{}{}
+			}
+		}
+	}
+
+	for _, name := range sortedNames(sweepers) {
+		r, ok := resources[name]
+
+		if !ok {
+			continue
+		}
+
+		for _, ref := range referencedResourceTypes(r, func(resourceType string) bool {
+			_, ok := sweepers[resourceType]
+			return ok
+		}) {
+			if ref == name {
+				continue
+			}
+
+			if dependsOn(deps, name, ref) {
+				continue
+			}
+
+			deps[ref][name] = struct{}{}
+		}
+	}
+
+	result := make(map[string][]string, len(deps))
+
+	for name, d := range deps {
+		result[name] = make([]string, 0, len(d))
+
+		for dep := range d {
+			result[name] = append(result[name], dep)
+		}
+
+		sort.Strings(result[name])
+	}
+
+	return result
+}
+
+// Filter returns the sweepers whose name contains any of the comma separated names in f, with their dependencies.
+// All sweepers are returned if f is empty.
+func Filter(f string, sweepers map[string]*Sweeper, dependencies map[string][]string) map[string]*Sweeper {
+	if f == "" {
+		return sweepers
+	}
+
+	result := make(map[string]*Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := sweepers[name]
+
+		if !ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dep := range dependencies[name] {
+			add(dep)
+		}
+	}
+
+	for _, filter := range strings.Split(f, ",") {
+		filter = strings.TrimSpace(filter)
+
+		if filter == "" {
+			continue
+		}
+
+		for name := range sweepers {
+			if strings.Contains(name, filter) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// dependsOn returns whether sweeper a depends on sweeper b, directly or transitively.
+func dependsOn(deps map[string]map[string]struct{}, a, b string) bool {
+	seen := make(map[string]bool)
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		if name == b {
+			return true
+		}
+
+		if seen[name] {
+			return false
+		}
+
+		seen[name] = true
+
+		for dep := range deps[name] {
+			if visit(dep) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return visit(a)
+}
+
+// referencedResourceTypes returns the sorted resource types referenced by the configurable attributes of a resource schema,
+// including those of nested blocks.
+func referencedResourceTypes(r *schema.Resource, isResourceType func(string) bool) []string {
+	refs := make(map[string]struct{})
+
+	var walk func(m map[string]*schema.Schema)
+	walk = func(m map[string]*schema.Schema) {
+		for k, v := range m {
+			if elem, ok := v.Elem.(*schema.Resource); ok {
+				walk(elem.Schema)
+				continue
+			}
+
+			if !v.Required && !v.Optional {
+				continue
+			}
+
+			if ref := referencedResourceType(k, isResourceType); ref != "" {
+				refs[ref] = struct{}{}
+			}
+		}
+	}
+
+	walk(r.Schema)
+
+	result := make([]string, 0, len(refs))
+
+	for ref := range refs {
+		result = append(result, ref)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// referencedResourceType returns the resource type referenced by an attribute, or "" if the attribute is not a reference.
+// The longest trailing sequence of words of the attribute name, without reference suffix, naming a resource type wins,
+// e.g. security_group_ids references aws_security_group and execution_role_arn references aws_iam_role.
+func referencedResourceType(attribute string, isResourceType func(string) bool) string {
+	stem := attribute
+
+	for _, suffix := range referenceSuffixes {
+		if strings.HasSuffix(attribute, suffix) {
+			stem = strings.TrimSuffix(attribute, suffix)
+			break
+		}
+	}
+
+	for _, stem := range []string{stem, strings.TrimSuffix(stem, "s")} {
+		words := strings.Split(stem, "_")
+
+		for i := range words {
+			name := strings.Join(words[i:], "_")
+
+			if name == "" {
+				continue
+			}
+
+			if resourceType := "aws_" + name; isResourceType(resourceType) {
+				return resourceType
+			}
+
+			if resourceType, ok := referenceAliases[name]; ok && isResourceType(resourceType) {
+				return resourceType
+			}
+		}
+	}
+
+	return ""
+}
+
+func sortedNames(sweepers map[string]*Sweeper) []string {
+	names := make([]string, 0, len(sweepers))
+
+	for name := range sweepers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
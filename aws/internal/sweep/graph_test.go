@@ -0,0 +1,134 @@
+package sweep
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReferencedResourceType(t *testing.T) {
+	resourceTypes := map[string]bool{
+		"aws_iam_role":       true,
+		"aws_s3_bucket":      true,
+		"aws_security_group": true,
+		"aws_subnet":         true,
+		"aws_vpc":            true,
+	}
+	isResourceType := func(resourceType string) bool { return resourceTypes[resourceType] }
+
+	testCases := []struct {
+		Attribute string
+		Expected  string
+	}{
+		{Attribute: "vpc_id", Expected: "aws_vpc"},
+		{Attribute: "subnet_ids", Expected: "aws_subnet"},
+		{Attribute: "subnets", Expected: "aws_subnet"},
+		{Attribute: "security_group_ids", Expected: "aws_security_group"},
+		{Attribute: "vpc_security_group_ids", Expected: "aws_security_group"},
+		{Attribute: "execution_role_arn", Expected: "aws_iam_role"},
+		{Attribute: "role", Expected: "aws_iam_role"},
+		{Attribute: "bucket", Expected: "aws_s3_bucket"},
+		{Attribute: "name", Expected: ""},
+		{Attribute: "arn", Expected: ""},
+		{Attribute: "kms_key_id", Expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		if got := referencedResourceType(testCase.Attribute, isResourceType); got != testCase.Expected {
+			t.Errorf("referencedResourceType(%q) = %q, expected %q", testCase.Attribute, got, testCase.Expected)
+		}
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	sweepers := map[string]*Sweeper{
+		"aws_instance":       {Name: "aws_instance"},
+		"aws_security_group": {Name: "aws_security_group", Dependencies: []string{"aws_instance", "aws_unknown"}},
+		"aws_subnet":         {Name: "aws_subnet"},
+		"aws_vpc":            {Name: "aws_vpc", Dependencies: []string{"aws_other"}},
+		"aws_other":          {Name: "aws_other"},
+	}
+
+	resources := map[string]*schema.Resource{
+		"aws_instance": {
+			Schema: map[string]*schema.Schema{
+				"subnet_id":              {Type: schema.TypeString, Optional: true},
+				"vpc_security_group_ids": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"network_interface": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"vpc_id": {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+			},
+		},
+		"aws_security_group": {
+			Schema: map[string]*schema.Schema{
+				"vpc_id": {Type: schema.TypeString, Optional: true},
+			},
+		},
+		"aws_subnet": {
+			Schema: map[string]*schema.Schema{
+				"vpc_id": {Type: schema.TypeString, Required: true},
+			},
+		},
+		"aws_vpc": {
+			Schema: map[string]*schema.Schema{
+				// Computed only attributes are not references.
+				"main_route_table_id": {Type: schema.TypeString, Computed: true},
+				// Referencing a sweeper which depends on this sweeper would introduce a cycle.
+				"other_id": {Type: schema.TypeString, Optional: true},
+			},
+		},
+		"aws_other": {
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+
+	expected := map[string][]string{
+		"aws_instance":       {},
+		"aws_other":          {},
+		"aws_security_group": {"aws_instance"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_vpc":            {"aws_instance", "aws_other", "aws_security_group", "aws_subnet"},
+	}
+
+	if got := Dependencies(sweepers, resources); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	sweepers := map[string]*Sweeper{
+		"aws_instance":       {Name: "aws_instance"},
+		"aws_security_group": {Name: "aws_security_group"},
+		"aws_subnet":         {Name: "aws_subnet"},
+		"aws_vpc":            {Name: "aws_vpc"},
+	}
+	dependencies := map[string][]string{
+		"aws_security_group": {"aws_instance"},
+		"aws_vpc":            {"aws_security_group", "aws_subnet"},
+	}
+
+	testCases := []struct {
+		Filter   string
+		Expected []string
+	}{
+		{Filter: "", Expected: []string{"aws_instance", "aws_security_group", "aws_subnet", "aws_vpc"}},
+		{Filter: "aws_instance", Expected: []string{"aws_instance"}},
+		{Filter: "security", Expected: []string{"aws_instance", "aws_security_group"}},
+		{Filter: "aws_subnet,aws_security_group", Expected: []string{"aws_instance", "aws_security_group", "aws_subnet"}},
+		{Filter: "vpc", Expected: []string{"aws_instance", "aws_security_group", "aws_subnet", "aws_vpc"}},
+		{Filter: "aws_unknown", Expected: []string{}},
+	}
+
+	for _, testCase := range testCases {
+		if got := sortedNames(Filter(testCase.Filter, sweepers, dependencies)); !reflect.DeepEqual(got, testCase.Expected) {
+			t.Errorf("Filter(%q) = %v, expected %v", testCase.Filter, got, testCase.Expected)
+		}
+	}
+}
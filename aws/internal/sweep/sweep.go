@@ -0,0 +1,420 @@
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	StatusFailed    = "failed"
+	StatusSkipped   = "skipped"
+	StatusSucceeded = "succeeded"
+)
+
+const (
+	ActionDeleted     = "deleted"
+	ActionFailed      = "failed"
+	ActionFiltered    = "filtered"
+	ActionWouldDelete = "would_delete"
+)
+
+// Sweeper sweeps the resources of one type from a region.
+// Exactly one of F or List must be set.
+type Sweeper struct {
+	Name string
+
+	// Dependencies are the names of the sweepers which must run before this sweeper.
+	// Further dependencies are inferred from resource schemas by the Dependencies function.
+	Dependencies []string
+
+	// F deletes all resources of the sweeper in a region.
+	// Sweepers with F are skipped when dry running or filtering.
+	F func(region string) error
+
+	// List returns the resources of the sweeper in a region.
+	// The resources are filtered and deleted by Run.
+	List func(region string) ([]*Resource, error)
+}
+
+// Resource is a resource returned by a sweeper's List function.
+type Resource struct {
+	// ID identifies the resource in logs and reports.
+	ID string
+
+	// Tags are the tags of the resource, nil if the resource does not support tags.
+	Tags map[string]string
+
+	// CreatedAt is the creation time of the resource, zero if unknown.
+	CreatedAt time.Time
+
+	// Delete deletes the resource.
+	Delete func() error
+}
+
+// Options configure a sweeper run.
+type Options struct {
+	// AllowFailures continues running sweepers after a sweeper fails.
+	AllowFailures bool
+
+	// DryRun lists the resources which would be deleted without deleting them.
+	DryRun bool
+
+	// MinAge excludes resources created less than MinAge ago, or whose creation time is unknown.
+	MinAge time.Duration
+
+	// Parallelism is the maximum number of sweepers run concurrently in a region. Defaults to 1.
+	Parallelism int
+
+	// Tags excludes resources without all of the tags. An empty tag value matches any value.
+	Tags map[string]string
+
+	now func() time.Time
+}
+
+// ParseTags parses a comma separated list of key=value or key tag filters.
+func ParseTags(s string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	for _, tag := range strings.Split(s, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "" {
+			continue
+		}
+
+		parts := strings.SplitN(tag, "=", 2)
+		key := strings.TrimSpace(parts[0])
+
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s): expected key=value or key", tag)
+		}
+
+		if len(parts) == 2 {
+			tags[key] = strings.TrimSpace(parts[1])
+		} else {
+			tags[key] = ""
+		}
+	}
+
+	return tags, nil
+}
+
+func (o *Options) filtering() bool {
+	return o.MinAge > 0 || len(o.Tags) > 0
+}
+
+// filterReason returns why a resource is excluded by the options, or "" if it is not excluded.
+func (o *Options) filterReason(r *Resource, now time.Time) string {
+	keys := make([]string, 0, len(o.Tags))
+
+	for key := range o.Tags {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value, ok := r.Tags[key]
+
+		if !ok {
+			return fmt.Sprintf("missing tag %s", key)
+		}
+
+		if expected := o.Tags[key]; expected != "" && value != expected {
+			return fmt.Sprintf("tag %s is %q", key, value)
+		}
+	}
+
+	if o.MinAge > 0 {
+		if r.CreatedAt.IsZero() {
+			return "unknown creation time"
+		}
+
+		if age := now.Sub(r.CreatedAt); age < o.MinAge {
+			return fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return ""
+}
+
+// Report is the result of a sweeper run.
+type Report struct {
+	DryRun  bool            `json:"dry_run"`
+	Regions []*RegionReport `json:"regions"`
+}
+
+// RegionReport is the result of running sweepers in a region.
+type RegionReport struct {
+	Region   string           `json:"region"`
+	Sweepers []*SweeperReport `json:"sweepers"`
+}
+
+// SweeperReport is the result of running a sweeper in a region.
+type SweeperReport struct {
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	Reason    string            `json:"reason,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	Duration  string            `json:"duration,omitempty"`
+	Resources []*ResourceReport `json:"resources,omitempty"`
+}
+
+// ResourceReport is the result of sweeping a resource.
+type ResourceReport struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Err returns an error listing the failed sweepers, or nil if no sweeper failed.
+func (r *Report) Err() error {
+	var errs *multierror.Error
+
+	for _, region := range r.Regions {
+		for _, s := range region.Sweepers {
+			if s.Status == StatusFailed {
+				errs = multierror.Append(errs, fmt.Errorf("sweeper (%s) failed in region (%s): %s", s.Name, region.Region, s.Error))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (r *SweeperReport) fail(err error) {
+	r.Status = StatusFailed
+	r.Error = err.Error()
+}
+
+func (r *SweeperReport) skip(reason string) {
+	r.Status = StatusSkipped
+	r.Reason = reason
+}
+
+// Run runs the sweepers in each region in turn.
+// Within a region, a sweeper runs once all of its dependencies have run, and up to opts.Parallelism sweepers run concurrently.
+// Unless opts.AllowFailures is set, no further sweepers are started once a sweeper fails.
+func Run(regions []string, sweepers map[string]*Sweeper, dependencies map[string][]string, opts Options) (*Report, error) {
+	if err := checkCycles(sweepers, dependencies); err != nil {
+		return nil, err
+	}
+
+	if opts.now == nil {
+		opts.now = time.Now
+	}
+
+	report := &Report{DryRun: opts.DryRun}
+
+	for _, region := range regions {
+		log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+		regionReport := runRegion(region, sweepers, dependencies, opts)
+		report.Regions = append(report.Regions, regionReport)
+
+		if !opts.AllowFailures && report.Err() != nil {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+func runRegion(region string, sweepers map[string]*Sweeper, dependencies map[string][]string, opts Options) *RegionReport {
+	names := sortedNames(sweepers)
+	reports := make(map[string]*SweeperReport, len(names))
+	done := make(map[string]chan struct{}, len(names))
+
+	for _, name := range names {
+		reports[name] = &SweeperReport{Name: name}
+		done[name] = make(chan struct{})
+	}
+
+	parallelism := opts.Parallelism
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	sem := make(chan struct{}, parallelism)
+
+	var mu sync.Mutex
+	var failed bool
+	var wg sync.WaitGroup
+
+	for _, name := range names {
+		wg.Add(1)
+
+		go func(name string) {
+			defer wg.Done()
+			defer close(done[name])
+
+			report := reports[name]
+
+			for _, dep := range dependencies[name] {
+				if _, ok := done[dep]; !ok {
+					continue
+				}
+
+				<-done[dep]
+
+				if reports[dep].Status == StatusFailed && !opts.AllowFailures {
+					report.skip(fmt.Sprintf("dependency %s failed", dep))
+					return
+				}
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			mu.Lock()
+			stop := failed && !opts.AllowFailures
+			mu.Unlock()
+
+			if stop {
+				report.skip("another sweeper failed")
+				return
+			}
+
+			runSweeper(region, sweepers[name], opts, report)
+
+			if report.Status == StatusFailed {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}(name)
+	}
+
+	wg.Wait()
+
+	regionReport := &RegionReport{Region: region}
+
+	for _, name := range names {
+		regionReport.Sweepers = append(regionReport.Sweepers, reports[name])
+	}
+
+	return regionReport
+}
+
+func runSweeper(region string, s *Sweeper, opts Options, report *SweeperReport) {
+	start := time.Now()
+
+	defer func() {
+		report.Duration = time.Since(start).Round(time.Millisecond).String()
+	}()
+
+	if s.List == nil {
+		if opts.DryRun || opts.filtering() {
+			report.skip("sweeper does not support dry runs or filters")
+			return
+		}
+
+		log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+
+		if err := s.F(region); err != nil {
+			report.fail(err)
+			return
+		}
+
+		report.Status = StatusSucceeded
+		return
+	}
+
+	resources, err := s.List(region)
+
+	if err != nil {
+		report.fail(fmt.Errorf("error listing resources: %w", err))
+		return
+	}
+
+	now := opts.now()
+	var errs *multierror.Error
+
+	for _, r := range resources {
+		resourceReport := &ResourceReport{ID: r.ID}
+		report.Resources = append(report.Resources, resourceReport)
+
+		if reason := opts.filterReason(r, now); reason != "" {
+			resourceReport.Action = ActionFiltered
+			resourceReport.Reason = reason
+			continue
+		}
+
+		if opts.DryRun {
+			log.Printf("[INFO] Would delete %s (%s) in region (%s)", s.Name, r.ID, region)
+			resourceReport.Action = ActionWouldDelete
+			continue
+		}
+
+		log.Printf("[INFO] Deleting %s (%s) in region (%s)", s.Name, r.ID, region)
+
+		if err := r.Delete(); err != nil {
+			log.Printf("[ERROR] Error deleting %s (%s) in region (%s): %s", s.Name, r.ID, region, err)
+			resourceReport.Action = ActionFailed
+			resourceReport.Error = err.Error()
+			errs = multierror.Append(errs, fmt.Errorf("error deleting %s (%s): %w", s.Name, r.ID, err))
+			continue
+		}
+
+		resourceReport.Action = ActionDeleted
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		report.fail(err)
+		return
+	}
+
+	report.Status = StatusSucceeded
+}
+
+// checkCycles returns an error if the dependencies of the sweepers contain a cycle.
+func checkCycles(sweepers map[string]*Sweeper, dependencies map[string][]string) error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := make(map[string]int, len(sweepers))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		for _, dep := range dependencies[name] {
+			if _, ok := sweepers[dep]; !ok {
+				continue
+			}
+
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, name := range sortedNames(sweepers) {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
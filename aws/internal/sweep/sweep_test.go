@@ -0,0 +1,280 @@
+package sweep
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseTags(t *testing.T) {
+	got, err := ParseTags("Environment=test, Owner ,Team=")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := map[string]string{"Environment": "test", "Owner": "", "Team": ""}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if _, err := ParseTags("=value"); err == nil {
+		t.Error("expected error for tag filter without key")
+	}
+}
+
+func TestRunOrder(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+
+	sweeper := func(name string) *Sweeper {
+		return &Sweeper{
+			Name: name,
+			F: func(region string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				order = append(order, region+"/"+name)
+				return nil
+			},
+		}
+	}
+
+	sweepers := map[string]*Sweeper{
+		"a": sweeper("a"),
+		"b": sweeper("b"),
+		"c": sweeper("c"),
+		"d": sweeper("d"),
+	}
+	dependencies := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"d"},
+	}
+
+	report, err := Run([]string{"us-west-2", "us-east-1"}, sweepers, dependencies, Options{Parallelism: 4})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := report.Err(); err != nil {
+		t.Fatalf("unexpected sweeper error: %s", err)
+	}
+
+	position := make(map[string]int)
+
+	for i, name := range order {
+		position[name] = i
+	}
+
+	if len(order) != 8 {
+		t.Fatalf("got %d sweeper runs, expected 8: %v", len(order), order)
+	}
+
+	for _, region := range []string{"us-west-2", "us-east-1"} {
+		for name, deps := range dependencies {
+			for _, dep := range deps {
+				if position[region+"/"+dep] > position[region+"/"+name] {
+					t.Errorf("sweeper %s ran before its dependency %s in %s: %v", name, dep, region, order)
+				}
+			}
+		}
+	}
+
+	if position["us-west-2/a"] > position["us-east-1/d"] {
+		t.Errorf("regions did not run in turn: %v", order)
+	}
+}
+
+func TestRunCycle(t *testing.T) {
+	sweepers := map[string]*Sweeper{
+		"a": {Name: "a"},
+		"b": {Name: "b"},
+	}
+	dependencies := map[string][]string{
+		"a": {"b"},
+		"b": {"a"},
+	}
+
+	if _, err := Run([]string{"us-west-2"}, sweepers, dependencies, Options{}); err == nil || err.Error() != "sweeper dependency cycle: a -> b -> a" {
+		t.Errorf("expected dependency cycle error, got: %v", err)
+	}
+}
+
+func TestRunFailure(t *testing.T) {
+	var ran []string
+
+	sweepers := map[string]*Sweeper{
+		"a": {Name: "a", F: func(string) error { ran = append(ran, "a"); return nil }},
+		"b": {Name: "b", F: func(string) error { ran = append(ran, "b"); return errors.New("boom") }},
+		"c": {Name: "c", F: func(string) error { ran = append(ran, "c"); return nil }},
+	}
+	dependencies := map[string][]string{
+		"a": {"b"},
+		"c": {"a"},
+	}
+
+	report, err := Run([]string{"us-west-2", "us-east-1"}, sweepers, dependencies, Options{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := ran, []string{"b"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got sweepers run %v, expected %v", got, expected)
+	}
+
+	if got := len(report.Regions); got != 1 {
+		t.Errorf("got %d regions, expected run to stop after the first region", got)
+	}
+
+	statuses := make(map[string]string)
+
+	for _, s := range report.Regions[0].Sweepers {
+		statuses[s.Name] = s.Status + " " + s.Reason + s.Error
+	}
+
+	expected := map[string]string{
+		"a": "skipped dependency b failed",
+		"b": "failed boom",
+		"c": "skipped another sweeper failed",
+	}
+
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("got %v, expected %v", statuses, expected)
+	}
+
+	if err := report.Err(); err == nil {
+		t.Error("expected report error")
+	}
+
+	ran = nil
+
+	if _, err := Run([]string{"us-west-2"}, sweepers, dependencies, Options{AllowFailures: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := ran, []string{"b", "a", "c"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got sweepers run %v with failures allowed, expected %v", got, expected)
+	}
+}
+
+func TestRunList(t *testing.T) {
+	now := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	var deleted []string
+
+	resource := func(id string, tags map[string]string, age time.Duration, err error) *Resource {
+		r := &Resource{
+			ID:   id,
+			Tags: tags,
+			Delete: func() error {
+				if err != nil {
+					return err
+				}
+
+				deleted = append(deleted, id)
+				return nil
+			},
+		}
+
+		if age > 0 {
+			r.CreatedAt = now.Add(-age)
+		}
+
+		return r
+	}
+
+	sweepers := map[string]*Sweeper{
+		"list": {
+			Name: "list",
+			List: func(string) ([]*Resource, error) {
+				return []*Resource{
+					resource("old", map[string]string{"Environment": "test"}, 48*time.Hour, nil),
+					resource("new", map[string]string{"Environment": "test"}, time.Hour, nil),
+					resource("untagged", map[string]string{}, 48*time.Hour, nil),
+					resource("production", map[string]string{"Environment": "production"}, 48*time.Hour, nil),
+					resource("unknown-age", map[string]string{"Environment": "test"}, 0, nil),
+					resource("protected", map[string]string{"Environment": "test"}, 48*time.Hour, errors.New("deletion protection")),
+				}, nil
+			},
+		},
+		"legacy": {
+			Name: "legacy",
+			F: func(string) error {
+				deleted = append(deleted, "legacy")
+				return nil
+			},
+		},
+	}
+
+	opts := Options{
+		AllowFailures: true,
+		DryRun:        true,
+		MinAge:        24 * time.Hour,
+		Tags:          map[string]string{"Environment": "test"},
+		now:           func() time.Time { return now },
+	}
+
+	report, err := Run([]string{"us-west-2"}, sweepers, nil, opts)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(deleted) != 0 {
+		t.Errorf("got resources deleted in dry run: %v", deleted)
+	}
+
+	expectedActions := map[string]string{
+		"old":         ActionWouldDelete,
+		"new":         ActionFiltered,
+		"untagged":    ActionFiltered,
+		"production":  ActionFiltered,
+		"unknown-age": ActionFiltered,
+		"protected":   ActionWouldDelete,
+	}
+
+	testCheckResourceActions(t, report, expectedActions)
+
+	if got := report.Regions[0].Sweepers[0]; got.Name != "legacy" || got.Status != StatusSkipped {
+		t.Errorf("got legacy sweeper %s %s, expected it to be skipped", got.Name, got.Status)
+	}
+
+	opts.DryRun = false
+
+	report, err = Run([]string{"us-west-2"}, sweepers, nil, opts)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := deleted, []string{"old"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got deleted %v, expected %v", got, expected)
+	}
+
+	expectedActions["old"] = ActionDeleted
+	expectedActions["protected"] = ActionFailed
+
+	testCheckResourceActions(t, report, expectedActions)
+
+	if got := report.Regions[0].Sweepers[1].Status; got != StatusFailed {
+		t.Errorf("got list sweeper status %s, expected %s", got, StatusFailed)
+	}
+}
+
+func testCheckResourceActions(t *testing.T, report *Report, expected map[string]string) {
+	t.Helper()
+
+	got := make(map[string]string)
+
+	for _, s := range report.Regions[0].Sweepers {
+		for _, r := range s.Resources {
+			got[r.ID] = r.Action
+		}
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got resource actions %v, expected %v", got, expected)
+	}
+}
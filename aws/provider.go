@@ -2,10 +2,15 @@ package aws
 
 import (
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retry"
 )
 
 // Provider returns a *schema.Provider.
@@ -87,6 +92,37 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"adopt_existing_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["adopt_existing_resources"],
+			},
+
+			"api_trace_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(apitrace.EnvVarPath, ""),
+				Description: descriptions["api_trace_path"],
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to default across all resources.",
+						},
+					},
+				},
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -114,6 +150,10 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"rate_limit": rateLimitSchema(),
+
+			"retry": retrySchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -189,7 +229,10 @@ func Provider() *schema.Provider {
 			"aws_canonical_user_id":                          dataSourceAwsCanonicalUserId(),
 			"aws_cloudformation_export":                      dataSourceAwsCloudFormationExport(),
 			"aws_cloudformation_stack":                       dataSourceAwsCloudFormationStack(),
+			"aws_cloudfront_cache_policy":                    dataSourceAwsCloudFrontCachePolicy(),
 			"aws_cloudfront_distribution":                    dataSourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_request_policy":           dataSourceAwsCloudFrontOriginRequestPolicy(),
+			"aws_cloudfront_realtime_log_config":             dataSourceAwsCloudFrontRealtimeLogConfig(),
 			"aws_cloudhsm_v2_cluster":                        dataSourceCloudHsmV2Cluster(),
 			"aws_cloudtrail_service_account":                 dataSourceAwsCloudTrailServiceAccount(),
 			"aws_cloudwatch_log_group":                       dataSourceAwsCloudwatchLogGroup(),
@@ -208,6 +251,7 @@ func Provider() *schema.Provider {
 			"aws_docdb_orderable_db_instance":                dataSourceAwsDocdbOrderableDbInstance(),
 			"aws_dx_gateway":                                 dataSourceAwsDxGateway(),
 			"aws_dynamodb_table":                             dataSourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_exports":                     dataSourceAwsDynamoDbTableExports(),
 			"aws_ebs_default_kms_key":                        dataSourceAwsEbsDefaultKmsKey(),
 			"aws_ebs_encryption_by_default":                  dataSourceAwsEbsEncryptionByDefault(),
 			"aws_ebs_snapshot":                               dataSourceAwsEbsSnapshot(),
@@ -265,6 +309,7 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":                       dataSourceAwsIAMInstanceProfile(),
 			"aws_iam_policy":                                 dataSourceAwsIAMPolicy(),
 			"aws_iam_policy_document":                        dataSourceAwsIamPolicyDocument(),
+			"aws_iam_policy_evaluation":                      dataSourceAwsIamPolicyEvaluation(),
 			"aws_iam_role":                                   dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":                     dataSourceAwsIAMServerCertificate(),
 			"aws_iam_user":                                   dataSourceAwsIAMUser(),
@@ -483,9 +528,12 @@ func Provider() *schema.Provider {
 			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
 			"aws_cloudformation_stack_set":                            resourceAwsCloudFormationStackSet(),
 			"aws_cloudformation_stack_set_instance":                   resourceAwsCloudFormationStackSetInstance(),
+			"aws_cloudfront_cache_policy":                             resourceAwsCloudFrontCachePolicy(),
 			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
 			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudfront_origin_request_policy":                    resourceAwsCloudFrontOriginRequestPolicy(),
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
+			"aws_cloudfront_realtime_log_config":                      resourceAwsCloudFrontRealtimeLogConfig(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
 			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
@@ -594,9 +642,12 @@ func Provider() *schema.Provider {
 			"aws_dx_private_virtual_interface":                        resourceAwsDxPrivateVirtualInterface(),
 			"aws_dx_public_virtual_interface":                         resourceAwsDxPublicVirtualInterface(),
 			"aws_dx_transit_virtual_interface":                        resourceAwsDxTransitVirtualInterface(),
+			"aws_dynamodb_contributor_insights":                       resourceAwsDynamoDbContributorInsights(),
 			"aws_dynamodb_table":                                      resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_export":                               resourceAwsDynamoDbTableExport(),
 			"aws_dynamodb_table_item":                                 resourceAwsDynamoDbTableItem(),
 			"aws_dynamodb_global_table":                               resourceAwsDynamoDbGlobalTable(),
+			"aws_dynamodb_kinesis_streaming_destination":              resourceAwsDynamoDbKinesisStreamingDestination(),
 			"aws_ebs_default_kms_key":                                 resourceAwsEbsDefaultKmsKey(),
 			"aws_ebs_encryption_by_default":                           resourceAwsEbsEncryptionByDefault(),
 			"aws_ebs_snapshot":                                        resourceAwsEbsSnapshot(),
@@ -908,7 +959,11 @@ func Provider() *schema.Provider {
 			"aws_s3_access_point":                                     resourceAwsS3AccessPoint(),
 			"aws_s3_account_public_access_block":                      resourceAwsS3AccountPublicAccessBlock(),
 			"aws_s3_bucket":                                           resourceAwsS3Bucket(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
 			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
 			"aws_s3_bucket_policy":                                    resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_public_access_block":                       resourceAwsS3BucketPublicAccessBlock(),
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
@@ -916,6 +971,10 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3control_bucket":                                    resourceAwsS3ControlBucket(),
 			"aws_s3control_bucket_policy":                             resourceAwsS3ControlBucketPolicy(),
 			"aws_s3control_bucket_lifecycle_configuration":            resourceAwsS3ControlBucketLifecycleConfiguration(),
@@ -1081,6 +1140,10 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	// Record resource and data source function calls and the API calls they issue when API tracing is enabled.
+	traceResources("", provider.ResourcesMap)
+	traceResources("data.", provider.DataSourcesMap)
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1123,6 +1186,14 @@ func init() {
 
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"adopt_existing_resources": "Adopt existing remote objects when resource creation fails\n" +
+			"because the object already exists, for resources supporting it. The object is\n" +
+			"imported and updated to match the configuration.",
+
+		"api_trace_path": "Path of a file to append JSON lines records of AWS API calls and resource\n" +
+			"operations to, with sensitive request parameters redacted. Can also be set with the\n" +
+			"TF_AWS_API_TRACE_PATH environment variable.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
@@ -1302,17 +1373,27 @@ func init() {
 }
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
-	config := Config{
+	return expandProviderConfig(d, terraformVersion).Client()
+}
+
+// expandProviderConfig returns the Config for the provider configuration.
+func expandProviderConfig(d *schema.ResourceData, terraformVersion string) *Config {
+	config := &Config{
 		AccessKey:               d.Get("access_key").(string),
+		AdoptExistingResources:  d.Get("adopt_existing_resources").(bool),
+		APITracePath:            d.Get("api_trace_path").(string),
 		SecretKey:               d.Get("secret_key").(string),
 		Profile:                 d.Get("profile").(string),
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
 		CredsFilename:           d.Get("shared_credentials_file").(string),
+		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
+		RateLimits:              make(map[string]ratelimit.Limit),
+		ServiceMaxRetries:       make(map[string]int),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1407,7 +1488,36 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
-	return config.Client()
+	for _, rateLimitRaw := range d.Get("rate_limit").([]interface{}) {
+		m, ok := rateLimitRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		config.RateLimits[m["service"].(string)] = ratelimit.Limit{
+			Burst:             m["burst"].(int),
+			RequestsPerSecond: m["requests_per_second"].(float64),
+		}
+	}
+
+	for _, retryRaw := range d.Get("retry").([]interface{}) {
+		m, ok := retryRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := m["service"].(string)
+
+		if v, ok := m["max_retries"].(int); ok && v > 0 {
+			config.ServiceMaxRetries[service] = v
+		}
+
+		config.RetryRules = append(config.RetryRules, expandProviderRetryRules(service, m["rule"].([]interface{}))...)
+	}
+
+	return config
 }
 
 // This is a global MutexKV for use within this plugin.
@@ -1468,6 +1578,99 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate of API requests to AWS services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of API requests to the service that can be sent at once. Defaults to requests_per_second rounded up.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The sustained rate of API requests to the service per region. 0 disables rate limiting for the service.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "AWS service identifier, lowercase without spaces, e.g. ec2 or iam.",
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to customize the retry behavior of AWS service clients.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of times an API request to the service is retried. Overrides the provider max_retries.",
+				},
+				"rule": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Additional API errors to retry for the service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"backoff": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validateDuration,
+								Description:  "Fixed delay between retries of the matched error, e.g. 5s. Defaults to exponential backoff.",
+							},
+							"error_code": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "AWS error code to retry.",
+							},
+							"error_message": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Substring of the AWS error message to retry.",
+							},
+							"max_retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The maximum number of times the matched error is retried.",
+							},
+							"operations": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Set:         schema.HashString,
+								Description: "API operation names to retry, e.g. ChangeResourceRecordSets. A trailing * matches by prefix. Defaults to all operations.",
+							},
+						},
+					},
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "AWS service identifier, lowercase without spaces, e.g. route53 or cloudwatchlogs.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1489,6 +1692,55 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	defaultConfig := &keyvaluetags.DefaultConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		defaultConfig.Tags = keyvaluetags.New(v)
+	}
+
+	return defaultConfig
+}
+
+func expandProviderRetryRules(service string, l []interface{}) []*retry.Rule {
+	var rules []*retry.Rule
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &retry.Rule{
+			Service:      service,
+			ErrorCode:    m["error_code"].(string),
+			ErrorMessage: m["error_message"].(string),
+			MaxRetries:   m["max_retries"].(int),
+		}
+
+		if v, ok := m["backoff"].(string); ok && v != "" {
+			// Validated by schema.
+			rule.Backoff, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := m["operations"].(*schema.Set); ok && v.Len() > 0 {
+			for _, operation := range v.List() {
+				rule.Operations = append(rule.Operations, operation.(string))
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
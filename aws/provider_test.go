@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/fakeaws"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/replay"
 )
 
 const (
@@ -169,6 +172,58 @@ func testAccProviderFactoriesMultipleRegion(providers *[]*schema.Provider, regio
 	return testAccProviderFactoriesInit(providers, providerNames)
 }
 
+// testAccProviderFactoriesReplay creates ProviderFactories for the main provider instance
+// whose API interactions are recorded to, or replayed from, the test's cassette file
+// according to the TF_ACC_REPLAY_MODE environment variable.
+//
+// Randomly generated values, such as resource names, must be passed in the same order
+// on every test run so they can be scrubbed from recorded interactions. Testing functions
+// must use the returned provider instance, e.g. via testAccCheck...WithProvider functions.
+// Usage typically paired with testAccPreCheckReplay.
+func testAccProviderFactoriesReplay(t *testing.T, providers *[]*schema.Provider, values ...string) map[string]func() (*schema.Provider, error) {
+	mode, err := replay.ModeFromEnv()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	factories := testAccProviderFactoriesInit(providers, []string{ProviderNameAws})
+
+	if mode == replay.ModeOff {
+		return factories
+	}
+
+	path := filepath.Join("testdata", "replay", t.Name()+".json")
+
+	if _, err := os.Stat(path); mode == replay.ModeReplay && os.IsNotExist(err) {
+		t.Skipf("skipping replay: cassette (%s) not recorded", path)
+	}
+
+	recorder, err := replay.New(mode, path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder.Scrub(values...)
+
+	t.Cleanup(func() {
+		if err := recorder.Save(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	p, _ := factories[ProviderNameAws]()
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config := expandProviderConfig(d, p.TerraformVersion)
+		config.ReplayRecorder = recorder
+
+		return config.Client()
+	}
+
+	return factories
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -216,6 +271,67 @@ func testAccPreCheck(t *testing.T) {
 	})
 }
 
+// testAccPreCheckReplay verifies and sets required provider testing configuration
+// for tests using testAccProviderFactoriesReplay.
+//
+// In replay mode no AWS credentials are required, otherwise it is equivalent to testAccPreCheck.
+func testAccPreCheckReplay(t *testing.T) {
+	if mode, _ := replay.ModeFromEnv(); mode != replay.ModeReplay {
+		testAccPreCheck(t)
+		return
+	}
+
+	os.Setenv("AWS_DEFAULT_REGION", testAccGetRegion())
+}
+
+// testFakeAwsClient starts an in-memory fake AWS endpoint and returns it with an AWSClient
+// configured to send requests for the services it serves to the fake via the endpoints configuration block.
+// The fake is stopped when the test completes.
+//
+// This allows unit testing resource CRUD functions without network access or AWS credentials.
+func testFakeAwsClient(t *testing.T) (*fakeaws.Server, *AWSClient) {
+	server, provider := testFakeAwsProvider(t, nil)
+
+	return server, provider.Meta().(*AWSClient)
+}
+
+// testFakeAwsProvider starts an in-memory fake AWS endpoint and returns it with a provider configured
+// as for testFakeAwsClient, with the given additional provider configuration.
+func testFakeAwsProvider(t *testing.T, config map[string]interface{}) (*fakeaws.Server, *schema.Provider) {
+	server := fakeaws.New()
+	t.Cleanup(server.Close)
+
+	endpoints := make(map[string]interface{})
+
+	for service, url := range server.Endpoints() {
+		endpoints[service] = url
+	}
+
+	raw := map[string]interface{}{
+		"access_key":              "fakeaws",
+		"endpoints":               []interface{}{endpoints},
+		"max_retries":             0,
+		"region":                  fakeaws.Region,
+		"secret_key":              "fakeaws",
+		"skip_get_ec2_platforms":  true,
+		"skip_metadata_api_check": true,
+		"skip_region_validation":  true,
+	}
+
+	for k, v := range config {
+		raw[k] = v
+	}
+
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+
+	if diags.HasError() {
+		t.Fatalf("error configuring provider: %v", diags)
+	}
+
+	return server, provider
+}
+
 // testAccAwsProviderAccountID returns the account ID of an AWS provider
 func testAccAwsProviderAccountID(provider *schema.Provider) string {
 	if provider == nil {
@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalImportIDSeparator separates a resource import ID from an optional region,
+// e.g. ID@us-west-2
+const regionalImportIDSeparator = "@"
+
+// regionalClientCache holds AWSClients keyed by region.
+// All clients in the cache share the credentials and session of the provider configuration.
+type regionalClientCache struct {
+	sync.Mutex
+	clients map[string]*AWSClient
+}
+
+// RegionalClient returns an AWSClient for the given region, creating and caching it on first use.
+// The provider region client is returned if region is empty or matches the provider region.
+func (client *AWSClient) RegionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == client.region {
+		return client, nil
+	}
+
+	if client.regionalClients == nil || client.providerConfig == nil || client.session == nil {
+		return nil, fmt.Errorf("unable to configure AWS client for region (%s): provider client not fully initialized", region)
+	}
+
+	client.regionalClients.Lock()
+	defer client.regionalClients.Unlock()
+
+	if regionalClient, ok := client.regionalClients.clients[region]; ok {
+		return regionalClient, nil
+	}
+
+	config := *client.providerConfig
+	config.Region = region
+
+	if !config.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	sess := client.session.Copy(&aws.Config{Region: aws.String(region)})
+	regionalClient := config.clientFromSession(sess, client.accountid, client.partition)
+	regionalClient.regionalClients = client.regionalClients
+	regionalClient.requestCache = client.requestCache
+
+	client.regionalClients.clients[region] = regionalClient
+
+	return regionalClient, nil
+}
+
+// regionSchema returns the schema to use for the per-resource region override.
+func regionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+}
+
+// regionalClient returns the AWSClient for the resource's configured region,
+// falling back to the provider region if none is configured.
+func regionalClient(d *schema.ResourceData, meta interface{}) (*AWSClient, error) {
+	return meta.(*AWSClient).RegionalClient(d.Get("region").(string))
+}
+
+// regionalImportStatePassthrough is a schema.StateFunc that accepts import IDs
+// optionally suffixed with a region, e.g. ID@us-west-2, setting the resource
+// ID and region accordingly.
+func regionalImportStatePassthrough(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, region, err := parseRegionalImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if region == "" {
+		region = meta.(*AWSClient).region
+	}
+
+	d.SetId(id)
+	d.Set("region", region)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseRegionalImportID splits an import ID of the form ID[@REGION].
+func parseRegionalImportID(importID string) (string, string, error) {
+	idx := strings.LastIndex(importID, regionalImportIDSeparator)
+
+	if idx == -1 {
+		return importID, "", nil
+	}
+
+	id, region := importID[:idx], importID[idx+1:]
+
+	if id == "" || region == "" {
+		return "", "", fmt.Errorf("unexpected format of import ID (%s), expected ID or ID%sREGION", importID, regionalImportIDSeparator)
+	}
+
+	return id, region, nil
+}
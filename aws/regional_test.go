@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestParseRegionalImportID(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectError    bool
+	}{
+		{
+			Name:       "ID only",
+			ImportID:   "/example/name",
+			ExpectedID: "/example/name",
+		},
+		{
+			Name:           "ID with region",
+			ImportID:       "/example/name@us-west-2", //lintignore:AWSAT003
+			ExpectedID:     "/example/name",
+			ExpectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name:        "empty region",
+			ImportID:    "/example/name@",
+			ExpectError: true,
+		},
+		{
+			Name:        "empty ID",
+			ImportID:    "@us-west-2", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			id, region, err := parseRegionalImportID(testCase.ImportID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", id, testCase.ExpectedID)
+			}
+
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	config := &Config{
+		Region:              "us-west-2", //lintignore:AWSAT003
+		SkipGetEC2Platforms: true,
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(config.Region),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error creating session: %s", err)
+	}
+
+	client := config.clientFromSession(sess, "123456789012", "aws")
+	client.regionalClients = &regionalClientCache{
+		clients: map[string]*AWSClient{config.Region: client},
+	}
+
+	got, err := client.RegionalClient("")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != client {
+		t.Errorf("expected provider client for empty region")
+	}
+
+	regional, err := client.RegionalClient("us-east-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if regional.region != "us-east-1" { //lintignore:AWSAT003
+		t.Errorf("got region %s, expected us-east-1", regional.region)
+	}
+
+	if got := aws.StringValue(regional.ssmconn.Config.Region); got != "us-east-1" { //lintignore:AWSAT003
+		t.Errorf("got SSM client region %s, expected us-east-1", got)
+	}
+
+	if regional.accountid != client.accountid {
+		t.Errorf("got account ID %s, expected %s", regional.accountid, client.accountid)
+	}
+
+	cached, err := regional.RegionalClient("us-east-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if cached != regional {
+		t.Errorf("expected cached regional client")
+	}
+
+	back, err := regional.RegionalClient(config.Region)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if back != client {
+		t.Errorf("expected cached provider region client")
+	}
+}
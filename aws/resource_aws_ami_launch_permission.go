@@ -9,6 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var amiLaunchPermissionImportID = importid.New("/",
+	importid.Field{Name: "ACCOUNT-ID", Attribute: "account_id"},
+	importid.Field{Name: "IMAGE-ID", Attribute: "image_id"},
 )
 
 func resourceAwsAmiLaunchPermission() *schema.Resource {
@@ -17,18 +23,9 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 		Read:   resourceAwsAmiLaunchPermissionRead,
 		Delete: resourceAwsAmiLaunchPermissionDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected ACCOUNT-ID/IMAGE-ID", d.Id())
-				}
-				accountId := idParts[0]
-				imageId := idParts[1]
-				d.Set("account_id", accountId)
-				d.Set("image_id", imageId)
-				d.SetId(fmt.Sprintf("%s-%s", imageId, accountId))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: amiLaunchPermissionImportID.StateFunc(func(parts importid.Parts) string {
+				return fmt.Sprintf("%s-%s", parts[1], parts[0])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -10,10 +10,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 )
 
 const defaultAuthorizerTTL = 300
 
+var apiGatewayAuthorizerImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "AUTHORIZER-ID"},
+)
+
 func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 	return &schema.Resource{
 		Create:        resourceAwsApiGatewayAuthorizerCreate,
@@ -22,17 +28,9 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 		Delete:        resourceAwsApiGatewayAuthorizerDelete,
 		CustomizeDiff: resourceAwsApiGatewayAuthorizerCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/AUTHORIZER-ID", d.Id())
-				}
-				restAPIId := idParts[0]
-				authorizerId := idParts[1]
-				d.Set("rest_api_id", restAPIId)
-				d.SetId(authorizerId)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayAuthorizerImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
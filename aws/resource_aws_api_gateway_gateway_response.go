@@ -3,11 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayGatewayResponseImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "RESPONSE-TYPE", Attribute: "response_type"},
 )
 
 func resourceAwsApiGatewayGatewayResponse() *schema.Resource {
@@ -17,18 +22,9 @@ func resourceAwsApiGatewayGatewayResponse() *schema.Resource {
 		Update: resourceAwsApiGatewayGatewayResponsePut,
 		Delete: resourceAwsApiGatewayGatewayResponseDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESPONSE-TYPE", d.Id())
-				}
-				restApiID := idParts[0]
-				responseType := idParts[1]
-				d.Set("response_type", responseType)
-				d.Set("rest_api_id", restApiID)
-				d.SetId(fmt.Sprintf("aggr-%s-%s", restApiID, responseType))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayGatewayResponseImportID.StateFunc(func(parts importid.Parts) string {
+				return fmt.Sprintf("aggr-%s-%s", parts[0], parts[1])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
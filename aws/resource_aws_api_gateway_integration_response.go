@@ -3,11 +3,18 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayIntegrationResponseImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "RESOURCE-ID", Attribute: "resource_id"},
+	importid.Field{Name: "HTTP-METHOD", Attribute: "http_method"},
+	importid.Field{Name: "STATUS-CODE", Attribute: "status_code"},
 )
 
 func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
@@ -17,22 +24,9 @@ func resourceAwsApiGatewayIntegrationResponse() *schema.Resource {
 		Update: resourceAwsApiGatewayIntegrationResponseCreate,
 		Delete: resourceAwsApiGatewayIntegrationResponseDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD/STATUS-CODE", d.Id())
-				}
-				restApiID := idParts[0]
-				resourceID := idParts[1]
-				httpMethod := idParts[2]
-				statusCode := idParts[3]
-				d.Set("http_method", httpMethod)
-				d.Set("status_code", statusCode)
-				d.Set("resource_id", resourceID)
-				d.Set("rest_api_id", restApiID)
-				d.SetId(fmt.Sprintf("agir-%s-%s-%s-%s", restApiID, resourceID, httpMethod, statusCode))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayIntegrationResponseImportID.StateFunc(func(parts importid.Parts) string {
+				return fmt.Sprintf("agir-%s-%s-%s-%s", parts[0], parts[1], parts[2], parts[3])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
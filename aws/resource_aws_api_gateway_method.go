@@ -4,11 +4,17 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayMethodImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "RESOURCE-ID", Attribute: "resource_id"},
+	importid.Field{Name: "HTTP-METHOD", Attribute: "http_method"},
 )
 
 func resourceAwsApiGatewayMethod() *schema.Resource {
@@ -18,20 +24,9 @@ func resourceAwsApiGatewayMethod() *schema.Resource {
 		Update: resourceAwsApiGatewayMethodUpdate,
 		Delete: resourceAwsApiGatewayMethodDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID/HTTP-METHOD", d.Id())
-				}
-				restApiID := idParts[0]
-				resourceID := idParts[1]
-				httpMethod := idParts[2]
-				d.Set("http_method", httpMethod)
-				d.Set("resource_id", resourceID)
-				d.Set("rest_api_id", restApiID)
-				d.SetId(fmt.Sprintf("agm-%s-%s-%s", restApiID, resourceID, httpMethod))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayMethodImportID.StateFunc(func(parts importid.Parts) string {
+				return fmt.Sprintf("agm-%s-%s-%s", parts[0], parts[1], parts[2])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -3,11 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayModelImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "NAME", Attribute: "name"},
 )
 
 func resourceAwsApiGatewayModel() *schema.Resource {
@@ -18,14 +23,15 @@ func resourceAwsApiGatewayModel() *schema.Resource {
 		Delete: resourceAwsApiGatewayModelDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/NAME", d.Id())
+				parts, err := apiGatewayModelImportID.Parse(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := apiGatewayModelImportID.Set(d, parts); err != nil {
+					return nil, err
 				}
-				restApiID := idParts[0]
-				name := idParts[1]
-				d.Set("name", name)
-				d.Set("rest_api_id", restApiID)
+				restApiID := parts[0]
+				name := parts[1]
 
 				conn := meta.(*AWSClient).apigatewayconn
 
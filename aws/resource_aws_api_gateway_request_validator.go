@@ -8,6 +8,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayRequestValidatorImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "REQUEST-VALIDATOR-ID"},
 )
 
 func resourceAwsApiGatewayRequestValidator() *schema.Resource {
@@ -17,17 +23,9 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 		Update: resourceAwsApiGatewayRequestValidatorUpdate,
 		Delete: resourceAwsApiGatewayRequestValidatorDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/REQUEST-VALIDATOR-ID", d.Id())
-				}
-				restApiID := idParts[0]
-				requestValidatorID := idParts[1]
-				d.Set("rest_api_id", restApiID)
-				d.SetId(requestValidatorID)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayRequestValidatorImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -3,11 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayResourceImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "RESOURCE-ID"},
 )
 
 func resourceAwsApiGatewayResource() *schema.Resource {
@@ -17,17 +22,9 @@ func resourceAwsApiGatewayResource() *schema.Resource {
 		Update: resourceAwsApiGatewayResourceUpdate,
 		Delete: resourceAwsApiGatewayResourceDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESOURCE-ID", d.Id())
-				}
-				restApiID := idParts[0]
-				resourceID := idParts[1]
-				d.Set("rest_api_id", restApiID)
-				d.SetId(resourceID)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayResourceImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
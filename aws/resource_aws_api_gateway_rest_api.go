@@ -50,6 +50,7 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 
 			"binary_media_types": {
@@ -372,7 +373,7 @@ func resourceAwsApiGatewayRestApiRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("error unescaping policy: %s", err)
 	}
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 
 	d.Set("binary_media_types", api.BinaryMediaTypes)
 
@@ -34,6 +34,7 @@ func resourceAwsApiGatewayRestApiPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -94,7 +95,7 @@ func resourceAwsApiGatewayRestApiPolicyRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return fmt.Errorf("error unescaping API Gateway REST API policy: %w", err)
 	}
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 	d.Set("rest_api_id", api.Id)
 
 	return nil
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,9 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+var apiGatewayStageImportID = importid.New("/",
+	importid.Field{Name: "REST-API-ID", Attribute: "rest_api_id"},
+	importid.Field{Name: "STAGE-NAME", Attribute: "stage_name"},
+)
+
 func resourceAwsApiGatewayStage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsApiGatewayStageCreate,
@@ -22,18 +27,9 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 		Update: resourceAwsApiGatewayStageUpdate,
 		Delete: resourceAwsApiGatewayStageDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME", d.Id())
-				}
-				restApiID := idParts[0]
-				stageName := idParts[1]
-				d.Set("stage_name", stageName)
-				d.Set("rest_api_id", restApiID)
-				d.SetId(fmt.Sprintf("ags-%s-%s", restApiID, stageName))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayStageImportID.StateFunc(func(parts importid.Parts) string {
+				return fmt.Sprintf("ags-%s-%s", parts[0], parts[1])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -3,11 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var apiGatewayUsagePlanKeyImportID = importid.New("/",
+	importid.Field{Name: "USAGE-PLAN-ID", Attribute: "usage_plan_id"},
+	importid.Field{Name: "USAGE-PLAN-KEY-ID", Attribute: "key_id"},
 )
 
 func resourceAwsApiGatewayUsagePlanKey() *schema.Resource {
@@ -16,18 +21,9 @@ func resourceAwsApiGatewayUsagePlanKey() *schema.Resource {
 		Read:   resourceAwsApiGatewayUsagePlanKeyRead,
 		Delete: resourceAwsApiGatewayUsagePlanKeyDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected USAGE-PLAN-ID/USAGE-PLAN-KEY-ID", d.Id())
-				}
-				usagePlanId := idParts[0]
-				usagePlanKeyId := idParts[1]
-				d.Set("usage_plan_id", usagePlanId)
-				d.Set("key_id", usagePlanKeyId)
-				d.SetId(usagePlanKeyId)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: apiGatewayUsagePlanKeyImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
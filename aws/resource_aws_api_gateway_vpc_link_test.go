@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	addTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    testSweepAPIGatewayVpcLinks,
 	})
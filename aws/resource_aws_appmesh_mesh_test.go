@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    testSweepAppmeshMeshes,
 		Dependencies: []string{
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    testSweepAppmeshRoutes,
 	})
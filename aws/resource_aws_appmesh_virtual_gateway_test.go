@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    testSweepAppmeshVirtualGateways,
 		Dependencies: []string{
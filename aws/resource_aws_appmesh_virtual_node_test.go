@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    testSweepAppmeshVirtualNodes,
 	})
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    testSweepAppmeshVirtualRouters,
 		Dependencies: []string{
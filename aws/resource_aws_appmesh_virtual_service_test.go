@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	addTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    testSweepAppmeshVirtualServices,
 	})
@@ -31,6 +31,7 @@ func resourceAwsBackupVaultPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"backup_vault_arn": {
 				Type:     schema.TypeString,
@@ -76,7 +77,7 @@ func resourceAwsBackupVaultPolicyRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error reading Backup Vault Policy (%s): %w", d.Id(), err)
 	}
 	d.Set("backup_vault_name", resp.BackupVaultName)
-	d.Set("policy", resp.Policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(resp.Policy)))
 	d.Set("backup_vault_arn", resp.BackupVaultArn)
 
 	return nil
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	addTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    testSweepBatchJobDefinitions,
 		Dependencies: []string{
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	addTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    testSweepBatchJobQueues,
 	})
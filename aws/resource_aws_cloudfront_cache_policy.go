@@ -0,0 +1,383 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func resourceAwsCloudFrontCachePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontCachePolicyCreate,
+		Read:   resourceAwsCloudFrontCachePolicyRead,
+		Update: resourceAwsCloudFrontCachePolicyUpdate,
+		Delete: resourceAwsCloudFrontCachePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31536000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parameters_in_cache_key_and_forwarded_to_origin": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookies_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cookie_behavior": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cloudfront.CachePolicyCookieBehavior_Values(), false),
+									},
+									"cookies": cloudFrontPolicyItemsSchema(),
+								},
+							},
+						},
+						"enable_accept_encoding_brotli": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"enable_accept_encoding_gzip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"headers_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_behavior": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cloudfront.CachePolicyHeaderBehavior_Values(), false),
+									},
+									"headers": cloudFrontPolicyItemsSchema(),
+								},
+							},
+						},
+						"query_strings_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"query_string_behavior": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cloudfront.CachePolicyQueryStringBehavior_Values(), false),
+									},
+									"query_strings": cloudFrontPolicyItemsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontCachePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateCachePolicyInput{
+		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Cache Policy: %s", input)
+	output, err := conn.CreateCachePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Cache Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CachePolicy.Id))
+
+	return resourceAwsCloudFrontCachePolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.CachePolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFront Cache Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontCachePolicyConfig(d, output.CachePolicy.CachePolicyConfig)
+}
+
+func resourceAwsCloudFrontCachePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateCachePolicyInput{
+		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
+		Id:                aws.String(d.Id()),
+		IfMatch:           aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Cache Policy: %s", input)
+	_, err := conn.UpdateCachePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Cache Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontCachePolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontCachePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Cache Policy: %s", d.Id())
+	_, err := conn.DeleteCachePolicy(&cloudfront.DeleteCachePolicyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchCachePolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Cache Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// cloudFrontPolicyItemsSchema returns the schema of the configuration blocks
+// holding the cookie, header and query string names of a CloudFront policy.
+func cloudFrontPolicyItemsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"items": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandCloudFrontCachePolicyConfig(d *schema.ResourceData) *cloudfront.CachePolicyConfig {
+	apiObject := &cloudfront.CachePolicyConfig{
+		DefaultTTL: aws.Int64(int64(d.Get("default_ttl").(int))),
+		MaxTTL:     aws.Int64(int64(d.Get("max_ttl").(int))),
+		MinTTL:     aws.Int64(int64(d.Get("min_ttl").(int))),
+		Name:       aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		apiObject.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters_in_cache_key_and_forwarded_to_origin"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.ParametersInCacheKeyAndForwardedToOrigin = expandCloudFrontCachePolicyParametersInCacheKeyAndForwardedToOrigin(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenCloudFrontCachePolicyConfig(d *schema.ResourceData, apiObject *cloudfront.CachePolicyConfig) error {
+	d.Set("comment", apiObject.Comment)
+	d.Set("default_ttl", apiObject.DefaultTTL)
+	d.Set("max_ttl", apiObject.MaxTTL)
+	d.Set("min_ttl", apiObject.MinTTL)
+	d.Set("name", apiObject.Name)
+
+	if err := d.Set("parameters_in_cache_key_and_forwarded_to_origin", flattenCloudFrontCachePolicyParametersInCacheKeyAndForwardedToOrigin(apiObject.ParametersInCacheKeyAndForwardedToOrigin)); err != nil {
+		return fmt.Errorf("error setting parameters_in_cache_key_and_forwarded_to_origin: %w", err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontCachePolicyParametersInCacheKeyAndForwardedToOrigin(tfMap map[string]interface{}) *cloudfront.ParametersInCacheKeyAndForwardedToOrigin {
+	apiObject := &cloudfront.ParametersInCacheKeyAndForwardedToOrigin{
+		EnableAcceptEncodingBrotli: aws.Bool(tfMap["enable_accept_encoding_brotli"].(bool)),
+		EnableAcceptEncodingGzip:   aws.Bool(tfMap["enable_accept_encoding_gzip"].(bool)),
+	}
+
+	if v, ok := tfMap["cookies_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.CookiesConfig = &cloudfront.CachePolicyCookiesConfig{
+			CookieBehavior: aws.String(m["cookie_behavior"].(string)),
+		}
+
+		if items := expandCloudFrontPolicyItems(m["cookies"]); items != nil {
+			apiObject.CookiesConfig.Cookies = &cloudfront.CookieNames{
+				Items:    items,
+				Quantity: aws.Int64(int64(len(items))),
+			}
+		}
+	}
+
+	if v, ok := tfMap["headers_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.HeadersConfig = &cloudfront.CachePolicyHeadersConfig{
+			HeaderBehavior: aws.String(m["header_behavior"].(string)),
+		}
+
+		if items := expandCloudFrontPolicyItems(m["headers"]); items != nil {
+			apiObject.HeadersConfig.Headers = &cloudfront.Headers{
+				Items:    items,
+				Quantity: aws.Int64(int64(len(items))),
+			}
+		}
+	}
+
+	if v, ok := tfMap["query_strings_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.QueryStringsConfig = &cloudfront.CachePolicyQueryStringsConfig{
+			QueryStringBehavior: aws.String(m["query_string_behavior"].(string)),
+		}
+
+		if items := expandCloudFrontPolicyItems(m["query_strings"]); items != nil {
+			apiObject.QueryStringsConfig.QueryStrings = &cloudfront.QueryStringNames{
+				Items:    items,
+				Quantity: aws.Int64(int64(len(items))),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCloudFrontCachePolicyParametersInCacheKeyAndForwardedToOrigin(apiObject *cloudfront.ParametersInCacheKeyAndForwardedToOrigin) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_accept_encoding_brotli": aws.BoolValue(apiObject.EnableAcceptEncodingBrotli),
+		"enable_accept_encoding_gzip":   aws.BoolValue(apiObject.EnableAcceptEncodingGzip),
+	}
+
+	if v := apiObject.CookiesConfig; v != nil {
+		m := map[string]interface{}{
+			"cookie_behavior": aws.StringValue(v.CookieBehavior),
+		}
+
+		if v.Cookies != nil {
+			m["cookies"] = flattenCloudFrontPolicyItems(v.Cookies.Items)
+		}
+
+		tfMap["cookies_config"] = []interface{}{m}
+	}
+
+	if v := apiObject.HeadersConfig; v != nil {
+		m := map[string]interface{}{
+			"header_behavior": aws.StringValue(v.HeaderBehavior),
+		}
+
+		if v.Headers != nil {
+			m["headers"] = flattenCloudFrontPolicyItems(v.Headers.Items)
+		}
+
+		tfMap["headers_config"] = []interface{}{m}
+	}
+
+	if v := apiObject.QueryStringsConfig; v != nil {
+		m := map[string]interface{}{
+			"query_string_behavior": aws.StringValue(v.QueryStringBehavior),
+		}
+
+		if v.QueryStrings != nil {
+			m["query_strings"] = flattenCloudFrontPolicyItems(v.QueryStrings.Items)
+		}
+
+		tfMap["query_strings_config"] = []interface{}{m}
+	}
+
+	return []interface{}{tfMap}
+}
+
+// expandCloudFrontPolicyItems returns the names in a configuration block built
+// by cloudFrontPolicyItemsSchema, or nil if none are configured.
+func expandCloudFrontPolicyItems(v interface{}) []*string {
+	l, ok := v.([]interface{})
+
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	items, ok := l[0].(map[string]interface{})["items"].(*schema.Set)
+
+	if !ok || items.Len() == 0 {
+		return nil
+	}
+
+	return expandStringSet(items)
+}
+
+func flattenCloudFrontPolicyItems(items []*string) []interface{} {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"items": flattenStringSet(items),
+		},
+	}
+}
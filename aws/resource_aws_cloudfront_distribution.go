@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -58,15 +59,20 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"compress": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -74,7 +80,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -142,19 +148,30 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
 						},
 						"path_pattern": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -220,15 +237,20 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"compress": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -236,7 +258,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -304,14 +326,25 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
+						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
 						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
@@ -485,6 +518,24 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"origin_shield": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"origin_shield_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`), "must be a valid AWS Region code"),
+									},
+								},
+							},
+						},
 						"s3_origin_config": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
+	addTestSweepers("aws_cloudhsm_v2_cluster", &resource.Sweeper{
 		Name: "aws_cloudhsm_v2_cluster",
 		F:    testSweepCloudhsmv2Clusters,
 	})
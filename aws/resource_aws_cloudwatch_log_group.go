@@ -14,13 +14,13 @@ import (
 )
 
 func resourceAwsCloudWatchLogGroup() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceAwsCloudWatchLogGroupCreate,
 		Read:   resourceAwsCloudWatchLogGroupRead,
 		Update: resourceAwsCloudWatchLogGroupUpdate,
 		Delete: resourceAwsCloudWatchLogGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: regionalImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,13 +55,26 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			"region": regionSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
+
+	return adoptExistingOnCreate(r, adoptExistingConflict{
+		Code: cloudwatchlogs.ErrCodeResourceAlreadyExistsException,
+		ImportID: func(d *schema.ResourceData) string {
+			return d.Get("name").(string)
+		},
+	})
 }
 
 func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 	tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().CloudwatchlogsTags()
 
 	var logGroupName string
@@ -87,12 +100,12 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 		params.Tags = tags
 	}
 
-	_, err := conn.CreateLogGroup(params)
+	_, err = conn.CreateLogGroup(params)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudwatchlogs.ErrCodeResourceAlreadyExistsException {
-			return fmt.Errorf("Creating CloudWatch Log Group failed: %s:  The CloudWatch Log Group '%s' already exists.", err, d.Get("name").(string))
+			return fmt.Errorf("Creating CloudWatch Log Group failed: %w:  The CloudWatch Log Group '%s' already exists.", err, d.Get("name").(string))
 		}
-		return fmt.Errorf("Creating CloudWatch Log Group failed: %s '%s'", err, d.Get("name"))
+		return fmt.Errorf("Creating CloudWatch Log Group failed: %w '%s'", err, d.Get("name"))
 	}
 
 	d.SetId(logGroupName)
@@ -116,7 +129,11 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	log.Printf("[DEBUG] Reading CloudWatch Log Group: %q", d.Get("name").(string))
@@ -135,6 +152,7 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", lg.LogGroupName)
 	d.Set("kms_key_id", lg.KmsKeyId)
 	d.Set("retention_in_days", lg.RetentionInDays)
+	d.Set("region", client.region)
 
 	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, d.Id())
 
@@ -171,7 +189,11 @@ func lookupCloudWatchLogGroup(conn *cloudwatchlogs.CloudWatchLogs, name string)
 }
 
 func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 
 	name := d.Id()
 	log.Printf("[DEBUG] Updating CloudWatch Log Group: %q", name)
@@ -231,9 +253,13 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	conn := client.cloudwatchlogsconn
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
-	_, err := conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
+	_, err = conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
 	})
 	if err != nil {
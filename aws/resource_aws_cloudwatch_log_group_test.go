@@ -5,19 +5,21 @@ import (
 	"log"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	addTestListSweepers("aws_cloudwatch_log_group", &sweep.Sweeper{
 		Name: "aws_cloudwatch_log_group",
-		F:    testSweepCloudwatchLogGroups,
+		List: testSweepCloudwatchLogGroups,
 		Dependencies: []string{
 			"aws_api_gateway_rest_api",
 			"aws_cloudhsm_v2_cluster",
@@ -43,17 +45,15 @@ func init() {
 	})
 }
 
-func testSweepCloudwatchLogGroups(region string) error {
+func testSweepCloudwatchLogGroups(region string) ([]*sweep.Resource, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*AWSClient).cloudwatchlogsconn
-	var sweeperErrs *multierror.Error
+	var resources []*sweep.Resource
 
-	input := &cloudwatchlogs.DescribeLogGroupsInput{}
-
-	err = conn.DescribeLogGroupsPages(input, func(page *cloudwatchlogs.DescribeLogGroupsOutput, isLast bool) bool {
+	err = conn.DescribeLogGroupsPages(&cloudwatchlogs.DescribeLogGroupsInput{}, func(page *cloudwatchlogs.DescribeLogGroupsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
 		}
@@ -63,20 +63,30 @@ func testSweepCloudwatchLogGroups(region string) error {
 				continue
 			}
 
-			input := &cloudwatchlogs.DeleteLogGroupInput{
-				LogGroupName: logGroup.LogGroupName,
-			}
 			name := aws.StringValue(logGroup.LogGroupName)
+			r := &sweep.Resource{
+				ID:        name,
+				CreatedAt: time.Unix(0, aws.Int64Value(logGroup.CreationTime)*int64(time.Millisecond)),
+				Delete: func() error {
+					_, err := conn.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
+						LogGroupName: aws.String(name),
+					})
+
+					if isAWSErr(err, cloudwatchlogs.ErrCodeResourceNotFoundException, "") {
+						return nil
+					}
+
+					return err
+				},
+			}
 
-			log.Printf("[INFO] Deleting CloudWatch Log Group: %s", name)
-			_, err := conn.DeleteLogGroup(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudWatch Log Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
+			if tags, err := keyvaluetags.CloudwatchlogsListTags(conn, name); err != nil {
+				log.Printf("[WARN] Error listing tags for CloudWatch Log Group (%s): %s", name, err)
+			} else {
+				r.Tags = tags.IgnoreAws().Map()
 			}
+
+			resources = append(resources, r)
 		}
 
 		return !isLast
@@ -84,14 +94,14 @@ func testSweepCloudwatchLogGroups(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping CloudWatch Log Groups sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil, nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudWatch Log Groups: %w", err))
+		return nil, fmt.Errorf("error retrieving CloudWatch Log Groups: %w", err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return resources, nil
 }
 
 func TestAccAWSCloudWatchLogGroup_basic(t *testing.T) {
@@ -34,6 +34,7 @@ func resourceAwsCloudWatchLogResourcePolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validateCloudWatchLogResourcePolicyDocument,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -73,7 +74,7 @@ func resourceAwsCloudWatchLogResourcePolicyRead(d *schema.ResourceData, meta int
 		return nil
 	}
 
-	d.Set("policy_document", resourcePolicy.PolicyDocument)
+	d.Set("policy_document", iamPolicyToSet(d.Get("policy_document").(string), aws.StringValue(resourcePolicy.PolicyDocument)))
 
 	return nil
 }
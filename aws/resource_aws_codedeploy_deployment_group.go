@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +14,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var codeDeployDeploymentGroupImportID = importid.New(":",
+	importid.Field{Name: "APPLICATION-NAME", Attribute: "app_name"},
+	importid.Field{Name: "DEPLOYMENT-GROUP-NAME", Attribute: "deployment_group_name"},
 )
 
 func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
@@ -25,14 +30,14 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 		Delete: resourceAwsCodeDeployDeploymentGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
+				parts, err := codeDeployDeploymentGroupImportID.Parse(d.Id())
 
-				if len(idParts) != 2 {
-					return []*schema.ResourceData{}, fmt.Errorf("expected ID in format ApplicationName:DeploymentGroupName, received: %s", d.Id())
+				if err != nil {
+					return []*schema.ResourceData{}, err
 				}
 
-				applicationName := idParts[0]
-				deploymentGroupName := idParts[1]
+				applicationName := parts[0]
+				deploymentGroupName := parts[1]
 				conn := meta.(*AWSClient).codedeployconn
 
 				input := &codedeploy.GetDeploymentGroupInput{
@@ -52,8 +57,10 @@ func resourceAwsCodeDeployDeploymentGroup() *schema.Resource {
 				}
 
 				d.SetId(aws.StringValue(output.DeploymentGroupInfo.DeploymentGroupId))
-				d.Set("app_name", applicationName)
-				d.Set("deployment_group_name", deploymentGroupName)
+
+				if err := codeDeployDeploymentGroupImportID.Set(d, parts); err != nil {
+					return []*schema.ResourceData{}, err
+				}
 
 				return []*schema.ResourceData{d}, nil
 			},
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	addTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    testSweepConfigConfigurationAggregators,
 	})
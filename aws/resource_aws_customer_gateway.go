@@ -7,11 +7,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -222,13 +222,15 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("customer-gateway/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2CustomerGateway{
+		Partition:         meta.(*AWSClient).partition,
+		Region:            meta.(*AWSClient).region,
+		AccountID:         meta.(*AWSClient).accountid,
+		CustomerGatewayID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Customer Gateway (%s) ARN: %w", d.Id(), err)
+	}
 
 	d.Set("arn", arn)
 
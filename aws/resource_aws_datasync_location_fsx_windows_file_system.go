@@ -3,16 +3,21 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+var dataSyncLocationFsxWindowsFileSystemImportID = importid.New("#",
+	importid.Field{Name: "DATASYNC-LOCATION-ARN", Type: importid.TypeARN},
+	importid.Field{Name: "FSX-FILESYSTEM-ARN", Attribute: "fsx_filesystem_arn", Type: importid.TypeARN},
+)
+
 func resourceAwsDataSyncLocationFsxWindowsFileSystem() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsDataSyncLocationFsxWindowsFileSystemCreate,
@@ -20,20 +25,9 @@ func resourceAwsDataSyncLocationFsxWindowsFileSystem() *schema.Resource {
 		Update: resourceAwsDataSyncLocationFsxWindowsFileSystemUpdate,
 		Delete: resourceAwsDataSyncLocationFsxWindowsFileSystemDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "#")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected DataSyncLocationArn#FsxArn", d.Id())
-				}
-
-				DSArn := idParts[0]
-				FSxArn := idParts[1]
-
-				d.Set("fsx_filesystem_arn", FSxArn)
-				d.SetId(DSArn)
-
-				return []*schema.ResourceData{d}, nil
-			},
+			State: dataSyncLocationFsxWindowsFileSystemImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[0]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
+	addTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
 		Name: "aws_datasync_location_smb",
 		F:    testSweepDataSyncLocationSmbs,
 	})
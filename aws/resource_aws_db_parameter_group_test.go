@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	addTestSweepers("aws_db_parameter_group", &resource.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    testSweepRdsDbParameterGroups,
 		Dependencies: []string{
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	addTestSweepers("aws_db_snapshot", &resource.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    testSweepDbSnapshots,
 		Dependencies: []string{
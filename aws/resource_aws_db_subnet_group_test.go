@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	addTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    testSweepRdsDbSubnetGroups,
 		Dependencies: []string{
@@ -7,10 +7,10 @@ import (
 	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
@@ -281,15 +281,17 @@ func resourceAwsDefaultSecurityGroupRead(d *schema.ResourceData, meta interface{
 	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
 	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
 
-	sgArn := arn.ARN{
-		AccountID: aws.StringValue(group.OwnerId),
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(group.GroupId)),
-		Service:   ec2.ServiceName,
+	sgArn, err := (&arns.EC2SecurityGroup{
+		Partition:       meta.(*AWSClient).partition,
+		Region:          meta.(*AWSClient).region,
+		AccountID:       aws.StringValue(group.OwnerId),
+		SecurityGroupID: aws.StringValue(group.GroupId),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Security Group (%s) ARN: %w", aws.StringValue(group.GroupId), err)
 	}
 
-	d.Set("arn", sgArn.String())
+	d.Set("arn", sgArn)
 	d.Set("description", group.Description)
 	d.Set("name", group.GroupName)
 	d.Set("owner_id", group.OwnerId)
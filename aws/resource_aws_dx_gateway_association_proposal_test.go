@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	addTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    testSweepDirectConnectGatewayAssociationProposals,
 	})
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	addTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    testSweepDirectConnectGatewayAssociations,
 		Dependencies: []string{
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	addTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    testSweepDirectConnectGateways,
 		Dependencies: []string{
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+var dynamoDbContributorInsightsID = importid.New("/",
+	importid.Field{Name: "TABLE-NAME", Attribute: "table_name"},
+	importid.Field{Name: "INDEX-NAME", Attribute: "index_name", Optional: true},
+)
+
+func resourceAwsDynamoDbContributorInsights() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbContributorInsightsCreate,
+		Read:   resourceAwsDynamoDbContributorInsightsRead,
+		Delete: resourceAwsDynamoDbContributorInsightsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: dynamoDbContributorInsightsID.StateFunc(nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbContributorInsightsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName := d.Get("table_name").(string)
+	indexName := d.Get("index_name").(string)
+	id := dynamoDbContributorInsightsID.Format(tableName, indexName)
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Enabling DynamoDB Contributor Insights: %s", input)
+	_, err := conn.UpdateContributorInsights(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling DynamoDB Contributor Insights (%s): %w", id, err)
+	}
+
+	if _, err := waiter.ContributorInsightsEnabled(conn, tableName, indexName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be enabled: %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsDynamoDbContributorInsightsRead(d, meta)
+}
+
+func resourceAwsDynamoDbContributorInsightsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	parts, err := dynamoDbContributorInsightsID.Parse(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tableName, indexName := parts[0], parts[1]
+
+	output, err := finder.ContributorInsights(conn, tableName, indexName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(output.ContributorInsightsStatus); status == dynamodb.ContributorInsightsStatusDisabled {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Contributor Insights (%s): status %s", d.Id(), status)
+		}
+
+		log.Printf("[WARN] DynamoDB Contributor Insights (%s) is %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("index_name", output.IndexName)
+	d.Set("table_name", output.TableName)
+
+	return nil
+}
+
+func resourceAwsDynamoDbContributorInsightsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	parts, err := dynamoDbContributorInsightsID.Parse(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tableName, indexName := parts[0], parts[1]
+
+	input := &dynamodb.UpdateContributorInsightsInput{
+		ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionDisable),
+		TableName:                 aws.String(tableName),
+	}
+
+	if indexName != "" {
+		input.IndexName = aws.String(indexName)
+	}
+
+	log.Printf("[DEBUG] Disabling DynamoDB Contributor Insights: %s", d.Id())
+	_, err = conn.UpdateContributorInsights(input)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling DynamoDB Contributor Insights (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ContributorInsightsDisabled(conn, tableName, indexName); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Contributor Insights (%s) to be disabled: %w", d.Id(), err)
+	}
+
+	return nil
+}
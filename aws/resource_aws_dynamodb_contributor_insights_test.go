@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSDynamoDbContributorInsights_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbContributorInsightsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbContributorInsightsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDynamoDbContributorInsightsConfigIndexName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbContributorInsightsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "index_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbContributorInsights_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbContributorInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbContributorInsightsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbContributorInsightsExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDynamoDbContributorInsights(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbContributorInsightsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no DynamoDB Contributor Insights ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		output, err := finder.ContributorInsights(conn, rs.Primary.Attributes["table_name"], rs.Primary.Attributes["index_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.ContributorInsightsStatus); status != dynamodb.ContributorInsightsStatusEnabled {
+			return fmt.Errorf("DynamoDB Contributor Insights (%s) status is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbContributorInsightsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_contributor_insights" {
+			continue
+		}
+
+		output, err := finder.ContributorInsights(conn, rs.Primary.Attributes["table_name"], rs.Primary.Attributes["index_name"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.ContributorInsightsStatus) == dynamodb.ContributorInsightsStatusDisabled {
+			continue
+		}
+
+		return fmt.Errorf("DynamoDB Contributor Insights %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSDynamoDbContributorInsightsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  attribute {
+    name = "gsi"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = %[1]q
+    hash_key        = "gsi"
+    read_capacity   = 1
+    write_capacity  = 1
+    projection_type = "KEYS_ONLY"
+  }
+}
+`, rName)
+}
+
+func testAccAWSDynamoDbContributorInsightsConfig(rName string) string {
+	return composeConfig(
+		testAccAWSDynamoDbContributorInsightsConfigBase(rName),
+		`
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+}
+`)
+}
+
+func testAccAWSDynamoDbContributorInsightsConfigIndexName(rName string) string {
+	return composeConfig(
+		testAccAWSDynamoDbContributorInsightsConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name = aws_dynamodb_table.test.name
+  index_name = %[1]q
+}
+`, rName))
+}
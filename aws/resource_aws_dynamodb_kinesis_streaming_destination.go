@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+var dynamoDbKinesisStreamingDestinationID = importid.New(",",
+	importid.Field{Name: "TABLE-NAME", Attribute: "table_name"},
+	importid.Field{Name: "STREAM-ARN", Attribute: "stream_arn", Type: importid.TypeARN},
+)
+
+func resourceAwsDynamoDbKinesisStreamingDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbKinesisStreamingDestinationCreate,
+		Read:   resourceAwsDynamoDbKinesisStreamingDestinationRead,
+		Delete: resourceAwsDynamoDbKinesisStreamingDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: dynamoDbKinesisStreamingDestinationID.StateFunc(nil),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbKinesisStreamingDestinationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	streamArn := d.Get("stream_arn").(string)
+	tableName := d.Get("table_name").(string)
+	input := &dynamodb.EnableKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamArn),
+		TableName: aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Enabling DynamoDB Kinesis Streaming Destination: %s", input)
+	_, err := conn.EnableKinesisStreamingDestination(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling DynamoDB Kinesis Streaming Destination (table: %s, stream: %s): %w", tableName, streamArn, err)
+	}
+
+	if _, err := waiter.KinesisStreamingDestinationActive(conn, tableName, streamArn); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Kinesis Streaming Destination (table: %s, stream: %s) to be active: %w", tableName, streamArn, err)
+	}
+
+	d.SetId(dynamoDbKinesisStreamingDestinationID.Format(tableName, streamArn))
+
+	return resourceAwsDynamoDbKinesisStreamingDestinationRead(d, meta)
+}
+
+func resourceAwsDynamoDbKinesisStreamingDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	parts, err := dynamoDbKinesisStreamingDestinationID.Parse(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tableName, streamArn := parts[0], parts[1]
+
+	destination, err := finder.KinesisStreamingDestination(conn, tableName, streamArn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Kinesis Streaming Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Kinesis Streaming Destination (%s): %w", d.Id(), err)
+	}
+
+	// Disabled destinations remain listed on the table.
+	if status := aws.StringValue(destination.DestinationStatus); status == dynamodb.DestinationStatusDisabled {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Kinesis Streaming Destination (%s): status %s", d.Id(), status)
+		}
+
+		log.Printf("[WARN] DynamoDB Kinesis Streaming Destination (%s) is %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("stream_arn", destination.StreamArn)
+	d.Set("table_name", tableName)
+
+	return nil
+}
+
+func resourceAwsDynamoDbKinesisStreamingDestinationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	parts, err := dynamoDbKinesisStreamingDestinationID.Parse(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	tableName, streamArn := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Disabling DynamoDB Kinesis Streaming Destination: %s", d.Id())
+	_, err = conn.DisableKinesisStreamingDestination(&dynamodb.DisableKinesisStreamingDestinationInput{
+		StreamArn: aws.String(streamArn),
+		TableName: aws.String(tableName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling DynamoDB Kinesis Streaming Destination (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.KinesisStreamingDestinationDisabled(conn, tableName, streamArn); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Kinesis Streaming Destination (%s) to be disabled: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestAccAWSDynamoDbKinesisStreamingDestination_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_kinesis_streaming_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "stream_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbKinesisStreamingDestination_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_kinesis_streaming_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDynamoDbKinesisStreamingDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbKinesisStreamingDestination_disappears_DynamoDbTable(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_dynamodb_kinesis_streaming_destination.test"
+	tableResourceName := "aws_dynamodb_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDynamoDbTable(), tableResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbKinesisStreamingDestinationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no DynamoDB Kinesis Streaming Destination ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		destination, err := finder.KinesisStreamingDestination(conn, rs.Primary.Attributes["table_name"], rs.Primary.Attributes["stream_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(destination.DestinationStatus); status != dynamodb.DestinationStatusActive {
+			return fmt.Errorf("DynamoDB Kinesis Streaming Destination (%s) status is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_kinesis_streaming_destination" {
+			continue
+		}
+
+		destination, err := finder.KinesisStreamingDestination(conn, rs.Primary.Attributes["table_name"], rs.Primary.Attributes["stream_arn"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(destination.DestinationStatus) == dynamodb.DestinationStatusDisabled {
+			continue
+		}
+
+		return fmt.Errorf("DynamoDB Kinesis Streaming Destination %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSDynamoDbKinesisStreamingDestinationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 2
+}
+
+resource "aws_dynamodb_kinesis_streaming_destination" "test" {
+  stream_arn = aws_kinesis_stream.test.arn
+  table_name = aws_dynamodb_table.test.name
+}
+`, rName)
+}
@@ -6,10 +6,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -153,12 +153,14 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
-	snapshotArn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Resource:  fmt.Sprintf("snapshot/%s", d.Id()),
-		Service:   "ec2",
-	}.String()
+	snapshotArn, err := (&arns.EC2Snapshot{
+		Partition:  meta.(*AWSClient).partition,
+		Region:     meta.(*AWSClient).region,
+		SnapshotID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EBS Snapshot (%s) ARN: %w", d.Id(), err)
+	}
 
 	d.Set("arn", snapshotArn)
 
@@ -7,12 +7,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -267,14 +267,16 @@ func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 
 	volume := response.Volumes[0]
 
-	arn := arn.ARN{
-		AccountID: meta.(*AWSClient).accountid,
+	arn, err := (&arns.EC2Volume{
 		Partition: meta.(*AWSClient).partition,
 		Region:    meta.(*AWSClient).region,
-		Resource:  fmt.Sprintf("volume/%s", d.Id()),
-		Service:   "ec2",
+		AccountID: meta.(*AWSClient).accountid,
+		VolumeID:  d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EBS Volume (%s) ARN: %w", d.Id(), err)
 	}
-	d.Set("arn", arn.String())
+	d.Set("arn", arn)
 	d.Set("availability_zone", volume.AvailabilityZone)
 	d.Set("encrypted", volume.Encrypted)
 	d.Set("iops", volume.Iops)
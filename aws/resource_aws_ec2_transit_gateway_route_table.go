@@ -5,10 +5,10 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -106,13 +106,15 @@ func resourceAwsEc2TransitGatewayRouteTableRead(d *schema.ResourceData, meta int
 
 	d.Set("transit_gateway_id", aws.StringValue(transitGatewayRouteTable.TransitGatewayId))
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("transit-gateway-route-table/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2TransitGatewayRouteTable{
+		Partition:                  meta.(*AWSClient).partition,
+		Region:                     meta.(*AWSClient).region,
+		AccountID:                  meta.(*AWSClient).accountid,
+		TransitGatewayRouteTableID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Transit Gateway Route Table (%s) ARN: %w", d.Id(), err)
+	}
 
 	d.Set("arn", arn)
 
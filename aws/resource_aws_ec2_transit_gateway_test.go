@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	addTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    testSweepEc2TransitGateways,
 		Dependencies: []string{
@@ -32,6 +32,7 @@ func resourceAwsEcrRepositoryPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -109,7 +110,7 @@ func resourceAwsEcrRepositoryPolicyRead(d *schema.ResourceData, meta interface{}
 	d.SetId(aws.StringValue(repositoryPolicy.RepositoryName))
 	d.Set("repository", repositoryPolicy.RepositoryName)
 	d.Set("registry_id", repositoryPolicy.RegistryId)
-	d.Set("policy", repositoryPolicy.PolicyText)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(repositoryPolicy.PolicyText)))
 
 	return nil
 }
@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	addTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    testSweepEcsClusters,
 		Dependencies: []string{
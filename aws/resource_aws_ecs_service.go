@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
 )
 
 func resourceAwsEcsService() *schema.Resource {
@@ -139,6 +140,25 @@ func resourceAwsEcsService() *schema.Resource {
 				Computed: true,
 			},
 
+			"deployment_circuit_breaker": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"deployment_controller": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -412,6 +432,14 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		input.DesiredCount = aws.Int64(int64(d.Get("desired_count").(int)))
 	}
 
+	if v := expandEcsDeploymentCircuitBreaker(d.Get("deployment_circuit_breaker").([]interface{})); v != nil {
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		input.DeploymentConfiguration.DeploymentCircuitBreaker = v
+	}
+
 	if v, ok := d.GetOk("cluster"); ok {
 		input.Cluster = aws.String(v.(string))
 	}
@@ -660,6 +688,10 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	if service.DeploymentConfiguration != nil {
 		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
 		d.Set("deployment_minimum_healthy_percent", service.DeploymentConfiguration.MinimumHealthyPercent)
+
+		if err := d.Set("deployment_circuit_breaker", flattenEcsDeploymentCircuitBreaker(service.DeploymentConfiguration.DeploymentCircuitBreaker)); err != nil {
+			return fmt.Errorf("error setting deployment_circuit_breaker: %s", err)
+		}
 	}
 
 	if err := d.Set("deployment_controller", flattenEcsDeploymentController(service.DeploymentController)); err != nil {
@@ -711,6 +743,47 @@ func expandEcsDeploymentController(l []interface{}) *ecs.DeploymentController {
 	return deploymentController
 }
 
+func expandEcsDeploymentCircuitBreaker(l []interface{}) *ecs.DeploymentCircuitBreaker {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ecs.DeploymentCircuitBreaker{
+		Enable:   aws.Bool(m["enable"].(bool)),
+		Rollback: aws.Bool(m["rollback"].(bool)),
+	}
+}
+
+// expandEcsDeploymentCircuitBreakerUpdate disables the circuit breaker when
+// the configuration block has been removed, the API otherwise keeps it enabled.
+func expandEcsDeploymentCircuitBreakerUpdate(d *schema.ResourceData) *ecs.DeploymentCircuitBreaker {
+	v := expandEcsDeploymentCircuitBreaker(d.Get("deployment_circuit_breaker").([]interface{}))
+
+	if v == nil && d.HasChange("deployment_circuit_breaker") {
+		return &ecs.DeploymentCircuitBreaker{
+			Enable:   aws.Bool(false),
+			Rollback: aws.Bool(false),
+		}
+	}
+
+	return v
+}
+
+func flattenEcsDeploymentCircuitBreaker(deploymentCircuitBreaker *ecs.DeploymentCircuitBreaker) []interface{} {
+	if deploymentCircuitBreaker == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enable":   aws.BoolValue(deploymentCircuitBreaker.Enable),
+		"rollback": aws.BoolValue(deploymentCircuitBreaker.Rollback),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenEcsDeploymentController(deploymentController *ecs.DeploymentController) []interface{} {
 	m := map[string]interface{}{
 		"type": ecs.DeploymentControllerTypeEcs,
@@ -950,10 +1023,11 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 	schedulingStrategy := d.Get("scheduling_strategy").(string)
 
 	if schedulingStrategy == ecs.SchedulingStrategyDaemon {
-		if d.HasChange("deployment_minimum_healthy_percent") {
+		if d.HasChanges("deployment_circuit_breaker", "deployment_minimum_healthy_percent") {
 			updateService = true
 			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{
-				MinimumHealthyPercent: aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
+				DeploymentCircuitBreaker: expandEcsDeploymentCircuitBreakerUpdate(d),
+				MinimumHealthyPercent:    aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
 			}
 		}
 	} else if schedulingStrategy == ecs.SchedulingStrategyReplica {
@@ -962,11 +1036,12 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 			input.DesiredCount = aws.Int64(int64(d.Get("desired_count").(int)))
 		}
 
-		if d.HasChanges("deployment_maximum_percent", "deployment_minimum_healthy_percent") {
+		if d.HasChanges("deployment_circuit_breaker", "deployment_maximum_percent", "deployment_minimum_healthy_percent") {
 			updateService = true
 			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{
-				MaximumPercent:        aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
-				MinimumHealthyPercent: aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
+				DeploymentCircuitBreaker: expandEcsDeploymentCircuitBreakerUpdate(d),
+				MaximumPercent:           aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
+				MinimumHealthyPercent:    aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
 			}
 		}
 	}
@@ -1198,7 +1273,32 @@ func waitForSteadyState(conn *ecs.ECS, d *schema.ResourceData) error {
 		input.Cluster = aws.String(v.(string))
 	}
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
+	output, err := conn.DescribeServices(input)
+	if err != nil {
+		return fmt.Errorf("error describing service (%s): %w", d.Id(), err)
+	}
+
+	// Follow the primary deployment so that a failed or rolled back
+	// deployment is reported instead of waiting for the previous one.
+	var deploymentID string
+	if len(output.Services) > 0 && output.Services[0] != nil {
+		for _, deployment := range output.Services[0].Deployments {
+			if aws.StringValue(deployment.Status) == waiter.ServiceDeploymentPrimary {
+				deploymentID = aws.StringValue(deployment.Id)
+				break
+			}
+		}
+	}
+
+	// Services using the CODE_DEPLOY or EXTERNAL deployment controllers have no deployments.
+	if deploymentID == "" {
+		if err := conn.WaitUntilServicesStable(input); err != nil {
+			return fmt.Errorf("error waiting for service (%s) to reach a steady state: %w", d.Id(), err)
+		}
+		return nil
+	}
+
+	if _, err := waiter.ServiceStable(conn, d.Get("cluster").(string), d.Id(), deploymentID, waiter.ServiceStableTimeout); err != nil {
 		return fmt.Errorf("error waiting for service (%s) to reach a steady state: %w", d.Id(), err)
 	}
 	return nil
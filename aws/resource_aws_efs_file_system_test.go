@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	addTestSweepers("aws_efs_file_system", &resource.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    testSweepEfsFileSystems,
 		Dependencies: []string{
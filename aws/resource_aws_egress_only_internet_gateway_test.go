@@ -12,7 +12,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	addTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    testSweepEc2EgressOnlyInternetGateways,
 	})
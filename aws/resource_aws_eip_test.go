@@ -20,7 +20,7 @@ import (
 // although we depend on aws_vpc to potentially have
 // the majority of those associations removed.
 func init() {
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	addTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_vpc",
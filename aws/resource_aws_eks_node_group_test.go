@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_eks_fargate_node_group", &resource.Sweeper{
+	addTestSweepers("aws_eks_fargate_node_group", &resource.Sweeper{
 		Name: "aws_eks_fargate_node_group",
 		F:    testSweepEksFargateNodegroups,
 	})
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
+	addTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
 		Name: "aws_elasticache_security_group",
 		F:    testSweepElasticacheCacheSecurityGroups,
 		Dependencies: []string{
@@ -65,6 +65,7 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"advanced_options": {
 				Type:     schema.TypeMap,
@@ -674,7 +675,7 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			return fmt.Errorf("access policies contain an invalid JSON: %s", err)
 		}
-		d.Set("access_policies", policies)
+		d.Set("access_policies", iamPolicyToSet(d.Get("access_policies").(string), policies))
 	}
 	err = d.Set("advanced_options", pointersMapToStringList(ds.AdvancedOptions))
 	if err != nil {
@@ -28,6 +28,7 @@ func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -51,7 +52,7 @@ func resourceAwsElasticSearchDomainPolicyRead(d *schema.ResourceData, meta inter
 	log.Printf("[DEBUG] Received ElasticSearch domain: %s", out)
 
 	ds := out.DomainStatus
-	d.Set("access_policies", ds.AccessPolicies)
+	d.Set("access_policies", iamPolicyToSet(d.Get("access_policies").(string), aws.StringValue(ds.AccessPolicies)))
 
 	return nil
 }
@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	addTestSweepers("aws_elb", &resource.Sweeper{
 		Name: "aws_elb",
 		F:    testSweepELBs,
 	})
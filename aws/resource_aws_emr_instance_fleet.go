@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var emrInstanceFleetImportID = importid.New("/",
+	importid.Field{Name: "CLUSTER-ID", Attribute: "cluster_id"},
+	importid.Field{Name: "FLEET-ID"},
 )
 
 func resourceAwsEMRInstanceFleet() *schema.Resource {
@@ -21,17 +26,9 @@ func resourceAwsEMRInstanceFleet() *schema.Resource {
 		Update: resourceAwsEMRInstanceFleetUpdate,
 		Delete: resourceAwsEMRInstanceFleetDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected cluster-id/fleet-id", d.Id())
-				}
-				clusterID := idParts[0]
-				resourceID := idParts[1]
-				d.Set("cluster_id", clusterID)
-				d.SetId(resourceID)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: emrInstanceFleetImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 )
 
 const (
@@ -20,6 +20,11 @@ const (
 	emrInstanceGroupUpdateTimeout = 30 * time.Minute
 )
 
+var emrInstanceGroupImportID = importid.New("/",
+	importid.Field{Name: "CLUSTER-ID", Attribute: "cluster_id"},
+	importid.Field{Name: "INSTANCE-GROUP-ID"},
+)
+
 func resourceAwsEMRInstanceGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEMRInstanceGroupCreate,
@@ -27,17 +32,9 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 		Update: resourceAwsEMRInstanceGroupUpdate,
 		Delete: resourceAwsEMRInstanceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected cluster-id/ig-id", d.Id())
-				}
-				clusterID := idParts[0]
-				resourceID := idParts[1]
-				d.Set("cluster_id", clusterID)
-				d.SetId(resourceID)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: emrInstanceGroupImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 		Schema: map[string]*schema.Schema{
 			"autoscaling_policy": {
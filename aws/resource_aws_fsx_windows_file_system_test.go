@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
+	addTestSweepers("aws_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_fsx_windows_file_system",
 		F:    testSweepFSXWindowsFileSystems,
 	})
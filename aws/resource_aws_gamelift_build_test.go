@@ -16,7 +16,7 @@ import (
 const testAccGameliftBuildPrefix = "tf_acc_build_"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    testSweepGameliftBuilds,
 	})
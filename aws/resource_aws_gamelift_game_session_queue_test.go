@@ -17,7 +17,7 @@ import (
 const testAccGameliftGameSessionQueuePrefix = "tfAccQueue-"
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	addTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    testSweepGameliftGameSessionQueue,
 	})
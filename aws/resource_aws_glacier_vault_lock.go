@@ -39,6 +39,7 @@ func resourceAwsGlacierVaultLock() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 				ValidateFunc:     validateIAMPolicyJson,
 			},
 			"vault_name": {
@@ -120,7 +121,7 @@ func resourceAwsGlacierVaultLockRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("complete_lock", aws.StringValue(output.State) == "Locked")
-	d.Set("policy", output.Policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy)))
 	d.Set("vault_name", d.Id())
 
 	return nil
@@ -26,6 +26,7 @@ func resourceAwsGlueResourcePolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -64,7 +65,7 @@ func resourceAwsGlueResourcePolicyRead(d *schema.ResourceData, meta interface{})
 		//Since the glue resource policy is global we expect it to be deleted when the policy is empty
 		d.SetId("")
 	} else {
-		d.Set("policy", resourcePolicy.PolicyInJson)
+		d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(resourcePolicy.PolicyInJson)))
 	}
 	return nil
 }
@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	addTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    testSweepGuarddutyPublishingDestinations,
 	})
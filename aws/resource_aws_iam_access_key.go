@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
 )
 
 func resourceAwsIamAccessKey() *schema.Resource {
@@ -47,9 +46,24 @@ func resourceAwsIamAccessKey() *schema.Resource {
 				Sensitive: true,
 			},
 			"pgp_key": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"kms_key_id", "age_recipient"},
+			},
+			"kms_key_id": {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ValidateFunc:  validateKmsKey,
+				ConflictsWith: []string{"pgp_key", "age_recipient"},
+			},
+			"age_recipient": {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ValidateFunc:  validateAgeRecipient,
+				ConflictsWith: []string{"pgp_key", "kms_key_id"},
 			},
 			"key_fingerprint": {
 				Type:     schema.TypeString,
@@ -85,13 +99,13 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("CreateAccessKey response did not contain a Secret Access Key as expected")
 	}
 
-	if v, ok := d.GetOk("pgp_key"); ok {
-		pgpKey := v.(string)
-		encryptionKey, err := encryption.RetrieveGPGKey(pgpKey)
-		if err != nil {
-			return err
-		}
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, *createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
+	encrypter, err := secretEncrypter(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if encrypter != nil {
+		fingerprint, encrypted, err := encrypter.Encrypt(*createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
 		if err != nil {
 			return err
 		}
@@ -64,6 +64,55 @@ func TestAccAWSAccessKey_encrypted(t *testing.T) {
 	})
 }
 
+func TestAccAWSAccessKey_encryptedKms(t *testing.T) {
+	var conf iam.AccessKeyMetadata
+	rName := fmt.Sprintf("test-user-%d", acctest.RandInt())
+	resourceName := "aws_iam_access_key.a_key"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessKeyConfig_encryptedKms(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAccessKeyExists(resourceName, &conf),
+					testAccCheckAWSAccessKeyAttributes(&conf, "Active"),
+					testAccCheckResourceAttrDecrypted(resourceName, "encrypted_secret", testAccKmsEnvelopeDecrypt, testAccCheckDecryptedLength(40)),
+					resource.TestCheckNoResourceAttr(resourceName, "secret"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_fingerprint", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAccessKey_encryptedAge(t *testing.T) {
+	var conf iam.AccessKeyMetadata
+	rName := fmt.Sprintf("test-user-%d", acctest.RandInt())
+	resourceName := "aws_iam_access_key.a_key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAccessKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessKeyConfig_encryptedAge(rName, testAgeRecipient1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAccessKeyExists(resourceName, &conf),
+					testAccCheckAWSAccessKeyAttributes(&conf, "Active"),
+					testAccCheckResourceAttrDecrypted(resourceName, "encrypted_secret", testAccAgeDecrypt(testAgeIdentity1), testAccCheckDecryptedLength(40)),
+					resource.TestCheckNoResourceAttr(resourceName, "secret"),
+					resource.TestCheckResourceAttr(resourceName, "key_fingerprint", testAgeRecipient1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAccessKey_inactive(t *testing.T) {
 	var conf iam.AccessKeyMetadata
 	rName := fmt.Sprintf("test-user-%d", acctest.RandInt())
@@ -222,6 +271,37 @@ EOF
 `, rName, key)
 }
 
+func testAccAWSAccessKeyConfig_encryptedKms(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_user" "a_user" {
+  name = %[1]q
+}
+
+resource "aws_iam_access_key" "a_key" {
+  user       = aws_iam_user.a_user.name
+  kms_key_id = aws_kms_key.test.arn
+}
+`, rName)
+}
+
+func testAccAWSAccessKeyConfig_encryptedAge(rName, recipient string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "a_user" {
+  name = %[1]q
+}
+
+resource "aws_iam_access_key" "a_key" {
+  user          = aws_iam_user.a_user.name
+  age_recipient = %[2]q
+}
+`, rName, recipient)
+}
+
 func testAccAWSAccessKeyConfig_inactive(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_user" "a_user" {
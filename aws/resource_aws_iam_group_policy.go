@@ -31,6 +31,7 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validateIAMPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -112,7 +113,7 @@ func resourceAwsIamGroupPolicyRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	if err := d.Set("policy", policy); err != nil {
+	if err := d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy)); err != nil {
 		return fmt.Errorf("error setting policy: %s", err)
 	}
 
@@ -41,6 +41,7 @@ func resourceAwsIamPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validateIAMPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -194,7 +195,7 @@ func resourceAwsIamPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 
 	return nil
 }
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	addTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    testSweepIamPolicies,
 		Dependencies: []string{
@@ -18,7 +18,7 @@ import (
 )
 
 func resourceAwsIamRole() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceAwsIamRoleCreate,
 		Read:   resourceAwsIamRoleRead,
 		Update: resourceAwsIamRoleUpdate,
@@ -88,6 +88,7 @@ func resourceAwsIamRole() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 
@@ -112,6 +113,13 @@ func resourceAwsIamRole() *schema.Resource {
 			"tags": tagsSchema(),
 		},
 	}
+
+	return adoptExistingOnCreate(r, adoptExistingConflict{
+		Code: iam.ErrCodeEntityAlreadyExistsException,
+		ImportID: func(d *schema.ResourceData) string {
+			return d.Get("name").(string)
+		},
+	})
 }
 
 func resourceAwsIamRoleImport(
@@ -172,7 +180,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		createResp, err = iamconn.CreateRole(request)
 	}
 	if err != nil {
-		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
+		return fmt.Errorf("Error creating IAM Role %s: %w", name, err)
 	}
 	d.SetId(aws.StringValue(createResp.Role.RoleName))
 	return resourceAwsIamRoleRead(d, meta)
@@ -225,7 +233,7 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	if err := d.Set("assume_role_policy", assumRolePolicy); err != nil {
+	if err := d.Set("assume_role_policy", iamPolicyToSet(d.Get("assume_role_policy").(string), assumRolePolicy)); err != nil {
 		return err
 	}
 	return nil
@@ -30,6 +30,7 @@ func resourceAwsIamRolePolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validateIAMPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -112,7 +113,7 @@ func resourceAwsIamRolePolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
-	if err := d.Set("policy", policy); err != nil {
+	if err := d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy)); err != nil {
 		return err
 	}
 	if err := d.Set("name", name); err != nil {
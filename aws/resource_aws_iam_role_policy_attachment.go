@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,7 +58,7 @@ func resourceAwsIamRolePolicyAttachmentRead(d *schema.ResourceData, meta interfa
 	role := d.Get("role").(string)
 	policyARN := d.Get("policy_arn").(string)
 
-	hasPolicyAttachment, err := iamRoleHasPolicyARNAttachment(conn, role, policyARN)
+	hasPolicyAttachment, err := iamRoleHasPolicyARNAttachment(conn, role, policyARN, meta.(*AWSClient).requestCache.Cacheable())
 
 	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
 		log.Printf("[WARN] IAM Role (%s) not found, removing from state", role)
@@ -130,13 +131,13 @@ func detachPolicyFromRole(conn *iam.IAM, role string, arn string) error {
 	return err
 }
 
-func iamRoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string) (bool, error) {
+func iamRoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string, optFns ...request.Option) (bool, error) {
 	hasPolicyAttachment := false
 	input := &iam.ListAttachedRolePoliciesInput{
 		RoleName: aws.String(role),
 	}
 
-	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+	err := conn.ListAttachedRolePoliciesPagesWithContext(aws.BackgroundContext(), input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
 		for _, p := range page.AttachedPolicies {
 			if aws.StringValue(p.PolicyArn) == policyARN {
 				hasPolicyAttachment = true
@@ -145,7 +146,7 @@ func iamRoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string)
 		}
 
 		return !lastPage
-	})
+	}, optFns...)
 
 	return hasPolicyAttachment, err
 }
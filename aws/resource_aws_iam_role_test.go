@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	addTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -83,25 +83,23 @@ func testSweepIamRoles(region string) error {
 	}
 	// Some acceptance tests use acctest.RandString(10) rather than acctest.RandomWithPrefix()
 	regex := regexp.MustCompile(`^[a-zA-Z0-9]{10}$`)
-	roles := make([]*iam.Role, 0)
+	var roles []string
 
-	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
-		for _, role := range page.Roles {
-			if regex.MatchString(aws.StringValue(role.RoleName)) {
-				roles = append(roles, role)
-				continue
-			}
+	names, err := listIamRoleNames(conn)
 
-			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(role.RoleName), prefix) {
-					roles = append(roles, role)
-					break
-				}
-			}
+	for _, name := range names {
+		if regex.MatchString(name) {
+			roles = append(roles, name)
+			continue
 		}
 
-		return !lastPage
-	})
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				roles = append(roles, name)
+				break
+			}
+		}
+	}
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
@@ -119,8 +117,7 @@ func testSweepIamRoles(region string) error {
 
 	var sweeperErrs *multierror.Error
 
-	for _, role := range roles {
-		rolename := aws.StringValue(role.RoleName)
+	for _, rolename := range roles {
 		log.Printf("[DEBUG] Deleting IAM Role (%s)", rolename)
 
 		err := deleteAwsIamRole(conn, rolename, true)
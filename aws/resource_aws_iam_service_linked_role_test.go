@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	addTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    testSweepIamServiceLinkedRoles,
 	})
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"math/big"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
 )
 
 func resourceAwsIamUserLoginProfile() *schema.Resource {
@@ -38,9 +36,24 @@ func resourceAwsIamUserLoginProfile() *schema.Resource {
 				ForceNew: true,
 			},
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"pgp_key", "kms_key_id", "age_recipient"},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKmsKey,
+				ExactlyOneOf: []string{"pgp_key", "kms_key_id", "age_recipient"},
+			},
+			"age_recipient": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAgeRecipient,
+				ExactlyOneOf: []string{"pgp_key", "kms_key_id", "age_recipient"},
 			},
 			"password_reset_required": {
 				Type:     schema.TypeBool,
@@ -126,9 +139,9 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 	iamconn := meta.(*AWSClient).iamconn
 	username := d.Get("user").(string)
 
-	encryptionKey, err := encryption.RetrieveGPGKey(strings.TrimSpace(d.Get("pgp_key").(string)))
+	encrypter, err := secretEncrypter(d, meta)
 	if err != nil {
-		return fmt.Errorf("error retrieving GPG Key during IAM User Login Profile (%s) creation: %s", username, err)
+		return fmt.Errorf("error retrieving encryption key during IAM User Login Profile (%s) creation: %s", username, err)
 	}
 
 	passwordResetRequired := d.Get("password_reset_required").(bool)
@@ -138,7 +151,7 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, initialPassword, "Password")
+	fingerprint, encrypted, err := encrypter.Encrypt(initialPassword, "Password")
 	if err != nil {
 		return fmt.Errorf("error encrypting password during IAM User Login Profile (%s) creation: %s", username, err)
 	}
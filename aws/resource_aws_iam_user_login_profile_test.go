@@ -97,6 +97,53 @@ func TestAccAWSUserLoginProfile_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSUserLoginProfile_encryptedKms(t *testing.T) {
+	var conf iam.GetLoginProfileOutput
+	resourceName := "aws_iam_user_login_profile.user"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	username := fmt.Sprintf("test-user-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserLoginProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserLoginProfileConfig_encryptedKms(username, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserLoginProfileExists(resourceName, &conf),
+					testAccCheckResourceAttrDecrypted(resourceName, "encrypted_password", testAccKmsEnvelopeDecrypt, testAccCheckDecryptedLength(20)),
+					resource.TestCheckResourceAttrPair(resourceName, "key_fingerprint", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSUserLoginProfile_encryptedAge(t *testing.T) {
+	var conf iam.GetLoginProfileOutput
+	resourceName := "aws_iam_user_login_profile.user"
+
+	username := fmt.Sprintf("test-user-%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSUserLoginProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSUserLoginProfileConfig_encryptedAge(username, "/", testAgeRecipient1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSUserLoginProfileExists(resourceName, &conf),
+					testAccCheckResourceAttrDecrypted(resourceName, "encrypted_password", testAccAgeDecrypt(testAgeIdentity1), testAccCheckDecryptedLength(20)),
+					resource.TestCheckResourceAttr(resourceName, "key_fingerprint", testAgeRecipient1),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSUserLoginProfile_keybase(t *testing.T) {
 	var conf iam.GetLoginProfileOutput
 
@@ -379,6 +426,33 @@ EOF
 `, testAccAWSUserLoginProfileConfig_base(rName, path), pgpKey)
 }
 
+func testAccAWSUserLoginProfileConfig_encryptedKms(rName, path string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_kms_key" "test" {
+  description             = %[2]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_user_login_profile" "user" {
+  user       = aws_iam_user.user.name
+  kms_key_id = aws_kms_key.test.arn
+}
+`, testAccAWSUserLoginProfileConfig_base(rName, path), rName)
+}
+
+func testAccAWSUserLoginProfileConfig_encryptedAge(rName, path, recipient string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_iam_user_login_profile" "user" {
+  user          = aws_iam_user.user.name
+  age_recipient = %q
+}
+`, testAccAWSUserLoginProfileConfig_base(rName, path), recipient)
+}
+
 const testPubKey1 = `mQENBFXbjPUBCADjNjCUQwfxKL+RR2GA6pv/1K+zJZ8UWIF9S0lk7cVIEfJiprzzwiMwBS5cD0da
 rGin1FHvIWOZxujA7oW0O2TUuatqI3aAYDTfRYurh6iKLC+VS+F7H+/mhfFvKmgr0Y5kDCF1j0T/
 063QZ84IRGucR/X43IY7kAtmxGXH0dYOCzOe5UBX1fTn3mXGe2ImCDWBH7gOViynXmb6XNvXkP0f
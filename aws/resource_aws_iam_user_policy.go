@@ -30,6 +30,7 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validateIAMPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -116,7 +117,7 @@ func resourceAwsIamUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
-	if err := d.Set("policy", policy); err != nil {
+	if err := d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy)); err != nil {
 		return err
 	}
 	if err := d.Set("name", name); err != nil {
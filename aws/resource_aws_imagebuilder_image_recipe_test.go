@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	addTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    testSweepImageBuilderImageRecipes,
 	})
@@ -13,12 +13,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
@@ -936,14 +936,16 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	// ARN
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "ec2",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("instance/%s", d.Id()),
+	arn, err := (&arns.EC2Instance{
+		Partition:  meta.(*AWSClient).partition,
+		Region:     meta.(*AWSClient).region,
+		AccountID:  meta.(*AWSClient).accountid,
+		InstanceID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Instance (%s) ARN: %w", d.Id(), err)
 	}
-	d.Set("arn", arn.String())
+	d.Set("arn", arn)
 
 	// Instance attributes
 	{
@@ -2616,7 +2618,7 @@ func expandEc2EnclaveOptions(l []interface{}) *ec2.EnclaveOptionsRequest {
 	return opts
 }
 
-//Expands an array of secondary Private IPs into a ec2 Private IP Address Spec
+// Expands an array of secondary Private IPs into a ec2 Private IP Address Spec
 func expandSecondaryPrivateIPAddresses(ips []interface{}) []*ec2.PrivateIpAddressSpecification {
 	specs := make([]*ec2.PrivateIpAddressSpecification, 0, len(ips))
 	for _, v := range ips {
@@ -22,7 +22,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	addTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    testSweepInstances,
 		Dependencies: []string{
@@ -6,11 +6,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -119,13 +119,15 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("owner_id", ig.OwnerId)
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("internet-gateway/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2InternetGateway{
+		Partition:         meta.(*AWSClient).partition,
+		Region:            meta.(*AWSClient).region,
+		AccountID:         meta.(*AWSClient).accountid,
+		InternetGatewayID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Internet Gateway (%s) ARN: %w", d.Id(), err)
+	}
 
 	d.Set("arn", arn)
 
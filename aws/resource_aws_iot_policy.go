@@ -29,6 +29,7 @@ func resourceAwsIotPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -80,7 +81,7 @@ func resourceAwsIotPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", out.PolicyArn)
 	d.Set("default_version_id", out.DefaultVersionId)
 	d.Set("name", out.PolicyName)
-	d.Set("policy", out.PolicyDocument)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(out.PolicyDocument)))
 
 	return nil
 }
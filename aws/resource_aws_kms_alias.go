@@ -3,14 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 )
 
 func resourceAwsKmsAlias() *schema.Resource {
@@ -112,18 +111,20 @@ func resourceAwsKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", alias.AliasArn)
 	d.Set("target_key_id", alias.TargetKeyId)
 
-	aliasARN, err := arn.Parse(*alias.AliasArn)
+	aliasARN, err := arns.ParseKMSAlias(aws.StringValue(alias.AliasArn))
 	if err != nil {
 		return err
 	}
-	targetKeyARN := arn.ARN{
+	targetKeyARN, err := (&arns.KMSKey{
 		Partition: aliasARN.Partition,
-		Service:   aliasARN.Service,
 		Region:    aliasARN.Region,
 		AccountID: aliasARN.AccountID,
-		Resource:  fmt.Sprintf("key/%s", *alias.TargetKeyId),
+		KeyID:     aws.StringValue(alias.TargetKeyId),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building KMS Key (%s) ARN: %w", aws.StringValue(alias.TargetKeyId), err)
 	}
-	d.Set("target_key_arn", targetKeyARN.String())
+	d.Set("target_key_arn", targetKeyARN)
 
 	return nil
 }
@@ -229,12 +230,11 @@ func resourceAwsKmsAliasImport(d *schema.ResourceData, meta interface{}) ([]*sch
 }
 
 func suppressEquivalentTargetKeyIdAndARN(k, old, new string, d *schema.ResourceData) bool {
-	newARN, err := arn.Parse(new)
+	newARN, err := arns.ParseKMSKey(new)
 	if err != nil {
-		log.Printf("[DEBUG] %q can not be parsed as an ARN: %q", new, err)
+		log.Printf("[DEBUG] %q can not be parsed as a KMS Key ARN: %q", new, err)
 		return false
 	}
 
-	resource := strings.TrimPrefix(newARN.Resource, "key/")
-	return old == resource
+	return old == newARN.KeyID
 }
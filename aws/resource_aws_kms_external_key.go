@@ -76,6 +76,7 @@ func resourceAwsKmsExternalKey() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(0, 32768),
 					validation.StringIsJSON,
@@ -237,7 +238,7 @@ func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("expiration_model", metadata.ExpirationModel)
 	d.Set("key_state", metadata.KeyState)
 	d.Set("key_usage", metadata.KeyUsage)
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 
 	tags, err := keyvaluetags.KmsListTags(conn, d.Id())
 	if err != nil {
@@ -74,6 +74,7 @@ func resourceAwsKmsKey() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"is_enabled": {
 				Type:     schema.TypeBool,
@@ -206,7 +207,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("policy contains an invalid JSON: %s", err)
 	}
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 
 	out, err := retryOnAwsCode(kms.ErrCodeNotFoundException, func() (interface{}, error) {
 		return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
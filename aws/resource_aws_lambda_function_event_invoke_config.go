@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 )
 
 func resourceAwsLambdaFunctionEventInvokeConfig() *schema.Resource {
@@ -276,26 +277,18 @@ func resourceAwsLambdaFunctionEventInvokeConfigDelete(d *schema.ResourceData, me
 
 func resourceAwsLambdaFunctionEventInvokeConfigParseId(id string) (string, string, error) {
 	if arn.IsARN(id) {
-		parsedARN, err := arn.Parse(id)
-
-		if err != nil {
-			return "", "", fmt.Errorf("error parsing ARN (%s): %s", id, err)
-		}
-
-		function := strings.TrimPrefix(parsedARN.Resource, "function:")
-
-		if !strings.Contains(function, ":") {
+		if _, err := arns.ParseLambdaFunction(id); err == nil {
 			// Return ARN for function name to match configuration
 			return id, "", nil
 		}
 
-		functionParts := strings.Split(function, ":")
+		functionARN, err := arns.ParseLambdaFunctionQualified(id)
 
-		if len(functionParts) != 2 || functionParts[0] == "" || functionParts[1] == "" {
-			return "", "", fmt.Errorf("unexpected format of function resource (%s), expected name:qualifier", id)
+		if err != nil {
+			return "", "", fmt.Errorf("unexpected format of function resource (%s), expected name:qualifier: %w", id, err)
 		}
 
-		qualifier := functionParts[1]
+		qualifier := functionARN.Qualifier
 		// Return ARN minus qualifier for function name to match configuration
 		functionName := strings.TrimSuffix(id, fmt.Sprintf(":%s", qualifier))
 
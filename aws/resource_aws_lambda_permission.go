@@ -9,15 +9,23 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 var LambdaFunctionRegexp = `^(arn:[\w-]+:lambda:)?([a-z]{2}-(?:[a-z]+-){1,2}\d{1}:)?(\d{12}:)?(function:)?([a-zA-Z0-9-_]+)(:(\$LATEST|[a-zA-Z0-9-_]+))?$`
 
+var lambdaPermissionImportID = importid.New("/",
+	importid.Field{Name: "FUNCTION_NAME[:QUALIFIER]", Type: importid.TypeARNOrName},
+	importid.Field{Name: "STATEMENT_ID"},
+)
+
 func resourceAwsLambdaPermission() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsLambdaPermissionCreate,
@@ -422,23 +430,28 @@ func getFunctionNameFromLambdaArn(arn string) (string, error) {
 }
 
 func resourceAwsLambdaPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/STATEMENT_ID or FUNCTION_NAME:QUALIFIER/STATEMENT_ID", d.Id())
+	parts, err := lambdaPermissionImportID.Parse(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	functionName := idParts[0]
+	functionName := parts[0]
 
-	input := &lambda.GetFunctionInput{FunctionName: &functionName}
+	input := &lambda.GetFunctionInput{FunctionName: aws.String(functionName)}
 
 	var qualifier string
-	fnParts := strings.Split(functionName, ":")
-	if len(fnParts) == 2 {
+	if arn.IsARN(functionName) {
+		if functionARN, err := arns.ParseLambdaFunctionQualified(functionName); err == nil {
+			functionName = functionARN.FunctionName
+			qualifier = functionARN.Qualifier
+			input.Qualifier = aws.String(qualifier)
+		}
+	} else if fnParts := strings.Split(functionName, ":"); len(fnParts) == 2 {
 		functionName = fnParts[0]
 		qualifier = fnParts[1]
-		input.Qualifier = &qualifier
+		input.Qualifier = aws.String(qualifier)
 	}
-	statementId := idParts[1]
+	statementId := parts[1]
 	log.Printf("[DEBUG] Importing Lambda Permission %s for function name %s", statementId, functionName)
 
 	conn := meta.(*AWSClient).lambdaconn
@@ -9,12 +9,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -735,13 +735,15 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("launch-template/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2LaunchTemplate{
+		Partition:        meta.(*AWSClient).partition,
+		Region:           meta.(*AWSClient).region,
+		AccountID:        meta.(*AWSClient).accountid,
+		LaunchTemplateID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Launch Template (%s) ARN: %w", d.Id(), err)
+	}
 	d.Set("arn", arn)
 
 	version := strconv.Itoa(int(*lt.LatestVersionNumber))
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	addTestSweepers("aws_lb_target_group", &resource.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    testSweepLBTargetGroups,
 		Dependencies: []string{
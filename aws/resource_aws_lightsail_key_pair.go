@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/terraform-providers/terraform-provider-aws/aws/internal/encryption"
 )
 
 func resourceAwsLightsailKeyPair() *schema.Resource {
@@ -36,9 +35,24 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 
 			// optional fields
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"kms_key_id", "age_recipient"},
+			},
+			"kms_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateKmsKey,
+				ConflictsWith: []string{"pgp_key", "age_recipient"},
+			},
+			"age_recipient": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateAgeRecipient,
+				ConflictsWith: []string{"pgp_key", "kms_key_id"},
 			},
 
 			// additional info returned from the API
@@ -63,7 +77,7 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 				Computed: true,
 			},
 
-			// encrypted fields if pgp_key is given
+			// encrypted fields if pgp_key, kms_key_id or age_recipient is given
 			"encrypted_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,16 +126,16 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 
 		// private_key and public_key are only available in the response from
 		// CreateKey pair. Here we set the public_key, and encrypt the private_key
-		// if a pgp_key is given, else we store the private_key in state
+		// if an encryption key is given, else we store the private_key in state
 		d.Set("public_key", resp.PublicKeyBase64)
 
-		// encrypt private key if pgp_key is given
-		pgpKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
+		// encrypt private key if pgp_key, kms_key_id or age_recipient is given
+		encrypter, err := secretEncrypter(d, meta)
 		if err != nil {
 			return err
 		}
-		if pgpKey != "" {
-			fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, *resp.PrivateKeyBase64, "Lightsail Private Key")
+		if encrypter != nil {
+			fingerprint, encrypted, err := encrypter.Encrypt(*resp.PrivateKeyBase64, "Lightsail Private Key")
 			if err != nil {
 				return err
 			}
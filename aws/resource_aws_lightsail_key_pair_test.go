@@ -3,6 +3,7 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -86,6 +87,30 @@ func TestAccAWSLightsailKeyPair_encrypted(t *testing.T) {
 	})
 }
 
+func TestAccAWSLightsailKeyPair_encryptedAge(t *testing.T) {
+	var conf lightsail.KeyPair
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt())
+	resourceName := "aws_lightsail_key_pair.lightsail_key_pair_test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailKeyPairDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailKeyPairConfig_encryptedAge(lightsailName, testAgeRecipient1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailKeyPairExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "encrypted_fingerprint", testAgeRecipient1),
+					testAccCheckResourceAttrDecrypted(resourceName, "encrypted_private_key", testAccAgeDecrypt(testAgeIdentity1), testAccCheckLightsailKeyPairPrivateKey),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLightsailKeyPair_nameprefix(t *testing.T) {
 	var conf1, conf2 lightsail.KeyPair
 
@@ -196,6 +221,23 @@ EOF
 `, lightsailName, key)
 }
 
+func testAccAWSLightsailKeyPairConfig_encryptedAge(lightsailName, recipient string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_key_pair" "lightsail_key_pair_test" {
+  name          = %[1]q
+  age_recipient = %[2]q
+}
+`, lightsailName, recipient)
+}
+
+func testAccCheckLightsailKeyPairPrivateKey(value string) error {
+	if !strings.Contains(value, "PRIVATE KEY-----") {
+		return fmt.Errorf("expected decrypted value to be a PEM encoded private key")
+	}
+
+	return nil
+}
+
 func testAccAWSLightsailKeyPairConfig_prefixed() string {
 	return `
 resource "aws_lightsail_key_pair" "lightsail_key_pair_test_omit" {}
@@ -29,6 +29,7 @@ func resourceAwsMediaStoreContainerPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validateIAMPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -74,7 +75,7 @@ func resourceAwsMediaStoreContainerPolicyRead(d *schema.ResourceData, meta inter
 	}
 
 	d.Set("container_name", d.Id())
-	d.Set("policy", resp.Policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(resp.Policy)))
 	return nil
 }
 
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
+	addTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    testSweepMskConfigurations,
 		Dependencies: []string{
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var networkAclRuleImportID = importid.New(":",
+	importid.Field{Name: "NETWORK_ACL_ID", Attribute: "network_acl_id"},
+	importid.Field{Name: "RULE_NUMBER", Attribute: "rule_number", Type: importid.TypeInt},
+	importid.Field{Name: "PROTOCOL"},
+	importid.Field{Name: "EGRESS", Attribute: "egress", Type: importid.TypeBool},
 )
 
 func resourceAwsNetworkAclRule() *schema.Resource {
@@ -21,28 +28,9 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
-				if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected NETWORK_ACL_ID:RULE_NUMBER:PROTOCOL:EGRESS", d.Id())
-				}
-				networkAclID := idParts[0]
-				ruleNumber, err := strconv.Atoi(idParts[1])
-				if err != nil {
-					return nil, err
-				}
-				protocol := idParts[2]
-				egress, err := strconv.ParseBool(idParts[3])
-				if err != nil {
-					return nil, err
-				}
-
-				d.Set("network_acl_id", networkAclID)
-				d.Set("rule_number", ruleNumber)
-				d.Set("egress", egress)
-				d.SetId(networkAclIdRuleNumberEgressHash(networkAclID, ruleNumber, egress, protocol))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: networkAclRuleImportID.StateFunc(func(parts importid.Parts) string {
+				return networkAclIdRuleNumberEgressHash(parts[0], parts.Int(1), parts.Bool(3), parts[2])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
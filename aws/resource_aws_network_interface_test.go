@@ -13,7 +13,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	addTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    testSweepEc2NetworkInterfaces,
 		Dependencies: []string{
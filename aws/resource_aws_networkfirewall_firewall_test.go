@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	addTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
 		Name:         "aws_networkfirewall_firewall",
 		F:            testSweepNetworkFirewallFirewalls,
 		Dependencies: []string{"aws_networkfirewall_logging_configuration"},
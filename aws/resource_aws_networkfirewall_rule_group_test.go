@@ -18,7 +18,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	addTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    testSweepNetworkFirewallRuleGroups,
 		Dependencies: []string{
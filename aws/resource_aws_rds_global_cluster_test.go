@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	addTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    testSweepRdsGlobalClusters,
 		Dependencies: []string{
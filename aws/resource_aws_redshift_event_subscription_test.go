@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
+	addTestSweepers("aws_redshift_event_subscription", &resource.Sweeper{
 		Name: "aws_redshift_event_subscription",
 		F:    testSweepRedshiftEventSubscriptions,
 	})
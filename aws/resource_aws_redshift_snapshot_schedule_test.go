@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
+	addTestSweepers("aws_redshift_snapshot_schedule", &resource.Sweeper{
 		Name: "aws_redshift_snapshot_schedule",
 		F:    testSweepRedshiftSnapshotSchedules,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
+	addTestSweepers("aws_redshift_subnet_group", &resource.Sweeper{
 		Name: "aws_redshift_subnet_group",
 		F:    testSweepRedshiftSubnetGroups,
 		Dependencies: []string{
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 )
 
 // How long to sleep if a limit-exceeded event happens
@@ -21,6 +23,11 @@ var routeTargetValidationError = errors.New("Error: more than 1 target specified
 	"vpc_endpoint_id, vpc_peering_connection_id is allowed.")
 
 // AWS Route resource Schema declaration
+var routeImportID = importid.New("_",
+	importid.Field{Name: "ROUTETABLEID", Attribute: "route_table_id"},
+	importid.Field{Name: "DESTINATION"},
+)
+
 func resourceAwsRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsRouteCreate,
@@ -29,13 +36,15 @@ func resourceAwsRoute() *schema.Resource {
 		Delete: resourceAwsRouteDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "_")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION", d.Id())
+				parts, err := routeImportID.Parse(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				if err := routeImportID.Set(d, parts); err != nil {
+					return nil, err
 				}
-				routeTableID := idParts[0]
-				destination := idParts[1]
-				d.Set("route_table_id", routeTableID)
+				routeTableID := parts[0]
+				destination := parts[1]
 				if strings.Contains(destination, ":") {
 					d.Set("destination_ipv6_cidr_block", destination)
 				} else {
@@ -380,7 +389,7 @@ func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
 	destinationIpv6CidrBlock := d.Get("destination_ipv6_cidr_block").(string)
 
-	route, err := resourceAwsRouteFindRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock)
+	route, err := resourceAwsRouteFindRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock, meta.(*AWSClient).requestCache.Cacheable())
 	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
 		log.Printf("[WARN] Route Table (%s) not found, removing from state", routeTableId)
 		d.SetId("")
@@ -580,14 +589,15 @@ func resourceAwsRouteID(d *schema.ResourceData, r *ec2.Route) string {
 
 // resourceAwsRouteFindRoute returns any route whose destination is the specified IPv4 or IPv6 CIDR block.
 // Returns nil if the route table exists but no matching destination is found.
-func resourceAwsRouteFindRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string) (*ec2.Route, error) {
+// Request options, such as opting into the provider request cache, are applied to the API request.
+func resourceAwsRouteFindRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string, optFns ...request.Option) (*ec2.Route, error) {
 	routeTableID := rtbid
 
 	findOpts := &ec2.DescribeRouteTablesInput{
 		RouteTableIds: []*string{&routeTableID},
 	}
 
-	resp, err := conn.DescribeRouteTables(findOpts)
+	resp, err := conn.DescribeRouteTablesWithContext(aws.BackgroundContext(), findOpts, optFns...)
 	if err != nil {
 		return nil, err
 	}
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	addTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    testSweepRoute53ResolverEndpoints,
 		Dependencies: []string{
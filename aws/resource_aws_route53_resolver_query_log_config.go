@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsRoute53ResolverQueryLogConfig() *schema.Resource {
@@ -79,7 +80,7 @@ func resourceAwsRoute53ResolverQueryLogConfigCreate(d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(output.ResolverQueryLogConfig.Id))
 
-	_, err = waiter.QueryLogConfigCreated(conn, d.Id())
+	_, err = waiter.QueryLogConfigCreated(conn, d.Id(), waiter.QueryLogConfigCreatedTimeout)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for Route53 Resolver Query Log Config (%s) to become available: %w", d.Id(), err)
@@ -92,9 +93,9 @@ func resourceAwsRoute53ResolverQueryLogConfigRead(d *schema.ResourceData, meta i
 	conn := meta.(*AWSClient).route53resolverconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
-	queryLogConfig, err := finder.ResolverQueryLogConfigByID(conn, d.Id())
+	queryLogConfig, err := finder.QueryLogConfigByID(conn, d.Id())
 
-	if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+	if tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Resolver Query Log Config (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -104,12 +105,6 @@ func resourceAwsRoute53ResolverQueryLogConfigRead(d *schema.ResourceData, meta i
 		return fmt.Errorf("error reading Route53 Resolver Query Log Config (%s): %w", d.Id(), err)
 	}
 
-	if queryLogConfig == nil {
-		log.Printf("[WARN] Route53 Resolver Query Log Config (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
 	arn := aws.StringValue(queryLogConfig.Arn)
 	d.Set("arn", arn)
 	d.Set("destination_arn", queryLogConfig.DestinationArn)
@@ -158,7 +153,7 @@ func resourceAwsRoute53ResolverQueryLogConfigDelete(d *schema.ResourceData, meta
 		return fmt.Errorf("error deleting Route53 Resolver Query Log Config (%s): %w", d.Id(), err)
 	}
 
-	_, err = waiter.QueryLogConfigDeleted(conn, d.Id())
+	_, err = waiter.QueryLogConfigDeleted(conn, d.Id(), waiter.QueryLogConfigDeletedTimeout)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for Route53 Resolver Query Log Config (%s) to be deleted: %w", d.Id(), err)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsRoute53ResolverQueryLogConfigAssociation() *schema.Resource {
@@ -53,7 +54,7 @@ func resourceAwsRoute53ResolverQueryLogConfigAssociationCreate(d *schema.Resourc
 
 	d.SetId(aws.StringValue(output.ResolverQueryLogConfigAssociation.Id))
 
-	_, err = waiter.QueryLogConfigAssociationCreated(conn, d.Id())
+	_, err = waiter.QueryLogConfigAssociationCreated(conn, d.Id(), waiter.QueryLogConfigAssociationCreatedTimeout)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for Route53 Resolver Query Log Config Association (%s) to become available: %w", d.Id(), err)
@@ -65,9 +66,9 @@ func resourceAwsRoute53ResolverQueryLogConfigAssociationCreate(d *schema.Resourc
 func resourceAwsRoute53ResolverQueryLogConfigAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).route53resolverconn
 
-	queryLogConfigAssociation, err := finder.ResolverQueryLogConfigAssociationByID(conn, d.Id())
+	queryLogConfigAssociation, err := finder.QueryLogConfigAssociationByID(conn, d.Id())
 
-	if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+	if tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Resolver Query Log Config Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -77,12 +78,6 @@ func resourceAwsRoute53ResolverQueryLogConfigAssociationRead(d *schema.ResourceD
 		return fmt.Errorf("error reading Route53 Resolver Query Log Config Association (%s): %w", d.Id(), err)
 	}
 
-	if queryLogConfigAssociation == nil {
-		log.Printf("[WARN] Route53 Resolver Query Log Config Association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
 	d.Set("resolver_query_log_config_id", queryLogConfigAssociation.ResolverQueryLogConfigId)
 	d.Set("resource_id", queryLogConfigAssociation.ResourceId)
 
@@ -106,7 +101,7 @@ func resourceAwsRoute53ResolverQueryLogConfigAssociationDelete(d *schema.Resourc
 		return fmt.Errorf("error deleting Route53 Resolver Query Log Config Association (%s): %w", d.Id(), err)
 	}
 
-	_, err = waiter.QueryLogConfigAssociationDeleted(conn, d.Id())
+	_, err = waiter.QueryLogConfigAssociationDeleted(conn, d.Id(), waiter.QueryLogConfigAssociationDeletedTimeout)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for Route53 Resolver Query Log Config Association (%s) to be deleted: %w", d.Id(), err)
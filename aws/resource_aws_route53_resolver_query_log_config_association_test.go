@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
+	addTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    testSweepRoute53ResolverQueryLogConfigAssociations,
 	})
@@ -125,9 +126,9 @@ func testAccCheckRoute53ResolverQueryLogConfigAssociationDestroy(s *terraform.St
 		}
 
 		// Try to find the resource
-		_, err := finder.ResolverQueryLogConfigAssociationByID(conn, rs.Primary.ID)
+		_, err := finder.QueryLogConfigAssociationByID(conn, rs.Primary.ID)
 		// Verify the error is what we want
-		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+		if tfresource.NotFound(err) {
 			continue
 		}
 		if err != nil {
@@ -151,7 +152,7 @@ func testAccCheckRoute53ResolverQueryLogConfigAssociationExists(n string, v *rou
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
-		out, err := finder.ResolverQueryLogConfigAssociationByID(conn, rs.Primary.ID)
+		out, err := finder.QueryLogConfigAssociationByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
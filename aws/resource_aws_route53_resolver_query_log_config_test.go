@@ -12,10 +12,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
+	addTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config",
 		F:    testSweepRoute53ResolverQueryLogConfigs,
 		Dependencies: []string{
@@ -188,9 +189,9 @@ func testAccCheckRoute53ResolverQueryLogConfigDestroy(s *terraform.State) error
 		}
 
 		// Try to find the resource
-		_, err := finder.ResolverQueryLogConfigByID(conn, rs.Primary.ID)
+		_, err := finder.QueryLogConfigByID(conn, rs.Primary.ID)
 		// Verify the error is what we want
-		if isAWSErr(err, route53resolver.ErrCodeResourceNotFoundException, "") {
+		if tfresource.NotFound(err) {
 			continue
 		}
 		if err != nil {
@@ -214,7 +215,7 @@ func testAccCheckRoute53ResolverQueryLogConfigExists(n string, v *route53resolve
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
-		out, err := finder.ResolverQueryLogConfigByID(conn, rs.Primary.ID)
+		out, err := finder.QueryLogConfigByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+	addTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule_association",
 		F:    testSweepRoute53ResolverRuleAssociations,
 	})
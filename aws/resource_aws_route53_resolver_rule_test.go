@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+	addTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule",
 		F:    testSweepRoute53ResolverRules,
 		Dependencies: []string{
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	addTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    testSweepRouteTables,
 	})
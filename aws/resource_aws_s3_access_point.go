@@ -63,6 +63,7 @@ func resourceAwsS3AccessPoint() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"public_access_block_configuration": {
 				Type:             schema.TypeList,
@@ -253,7 +254,7 @@ func resourceAwsS3AccessPointRead(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("error reading S3 Access Point (%s) policy: %s", d.Id(), err)
 		}
 
-		d.Set("policy", policyOutput.Policy)
+		d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(policyOutput.Policy)))
 	}
 
 	// Return early since S3 on Outposts cannot have public policies
@@ -35,6 +35,7 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -87,7 +88,7 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 	if err == nil && pol.Policy != nil {
 		v = *pol.Policy
 	}
-	if err := d.Set("policy", v); err != nil {
+	if err := d.Set("policy", iamPolicyToSet(d.Get("policy").(string), v)); err != nil {
 		return err
 	}
 	if err := d.Set("bucket", d.Id()); err != nil {
@@ -30,7 +30,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	addTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    testSweepS3Buckets,
 		Dependencies: []string{
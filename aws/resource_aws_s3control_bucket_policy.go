@@ -35,6 +35,7 @@ func resourceAwsS3ControlBucketPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -108,7 +109,7 @@ func resourceAwsS3ControlBucketPolicyRead(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("bucket", d.Id())
-	d.Set("policy", output.Policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy)))
 
 	return nil
 }
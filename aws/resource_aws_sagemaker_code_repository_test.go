@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
 		Name: "aws_sagemaker_code_repository",
 		F:    testSweepSagemakerCodeRepositories,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_domain", &resource.Sweeper{
 		Name: "aws_sagemaker_domain",
 		F:    testSweepSagemakerDomains,
 		Dependencies: []string{
@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_feature_group", &resource.Sweeper{
 		Name: "aws_sagemaker_feature_group",
 		F:    testSweepSagemakerFeatureGroups,
 	})
@@ -16,7 +16,7 @@ import (
 const SagemakerNotebookInstanceLifecycleConfigurationResourcePrefix = "tf-acc-test"
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    testSweepSagemakerNotebookInstanceLifecycleConfiguration,
 		Dependencies: []string{
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_notebook_instance", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance",
 		F:    testSweepSagemakerNotebookInstances,
 	})
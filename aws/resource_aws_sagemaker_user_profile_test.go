@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+	addTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
 		Name: "aws_sagemaker_user_profile",
 		F:    testSweepSagemakerUserProfiles,
 		Dependencies: []string{
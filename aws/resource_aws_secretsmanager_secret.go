@@ -61,6 +61,7 @@ func resourceAwsSecretsManagerSecret() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"recovery_window_in_days": {
 				Type:     schema.TypeInt,
@@ -248,7 +249,7 @@ func resourceAwsSecretsManagerSecretRead(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("policy contains an invalid JSON: %w", err)
 		}
-		d.Set("policy", policy)
+		d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 	}
 
 	d.Set("rotation_enabled", output.RotationEnabled)
@@ -35,6 +35,7 @@ func resourceAwsSecretsManagerSecretPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"block_public_policy": {
 				Type:     schema.TypeBool,
@@ -105,7 +106,7 @@ func resourceAwsSecretsManagerSecretPolicyRead(d *schema.ResourceData, meta inte
 		if err != nil {
 			return fmt.Errorf("policy contains an invalid JSON: %w", err)
 		}
-		d.Set("policy", policy)
+		d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 	} else {
 		d.Set("policy", "")
 	}
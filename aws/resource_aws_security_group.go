@@ -10,12 +10,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/naming"
@@ -370,15 +370,17 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
 	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
 
-	sgArn := arn.ARN{
-		AccountID: aws.StringValue(sg.OwnerId),
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Resource:  fmt.Sprintf("security-group/%s", aws.StringValue(sg.GroupId)),
-		Service:   ec2.ServiceName,
+	sgArn, err := (&arns.EC2SecurityGroup{
+		Partition:       meta.(*AWSClient).partition,
+		Region:          meta.(*AWSClient).region,
+		AccountID:       aws.StringValue(sg.OwnerId),
+		SecurityGroupID: aws.StringValue(sg.GroupId),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 Security Group (%s) ARN: %w", aws.StringValue(sg.GroupId), err)
 	}
 
-	d.Set("arn", sgArn.String())
+	d.Set("arn", sgArn)
 	d.Set("description", sg.Description)
 	d.Set("name", sg.GroupName)
 	d.Set("name_prefix", aws.StringValue(naming.NamePrefixFromName(aws.StringValue(sg.GroupName))))
@@ -839,7 +841,6 @@ func waitForSgToExist(conn *ec2.EC2, id string, timeout time.Duration) (interfac
 // remote rule, which may be structured differently because of how AWS
 // aggregates the rules under the to, from, and type.
 //
-//
 // Matching rules are written to state, with their elements removed from the
 // remote set
 //
@@ -1239,31 +1240,31 @@ func resourceAwsSecurityGroupCollapseRules(ruleset string, rules []interface{})
 //
 // For example, in terraform syntax, the following block:
 //
-// ingress {
-//   from_port = 80
-//   to_port = 80
-//   protocol = "tcp"
-//   cidr_blocks = [
-//     "192.168.0.1/32",
-//     "192.168.0.2/32",
-//   ]
-// }
+//	ingress {
+//	  from_port = 80
+//	  to_port = 80
+//	  protocol = "tcp"
+//	  cidr_blocks = [
+//	    "192.168.0.1/32",
+//	    "192.168.0.2/32",
+//	  ]
+//	}
 //
 // will be converted to the two blocks below:
 //
-// ingress {
-//   from_port = 80
-//   to_port = 80
-//   protocol = "tcp"
-//   cidr_blocks = [ "192.168.0.1/32" ]
-// }
+//	ingress {
+//	  from_port = 80
+//	  to_port = 80
+//	  protocol = "tcp"
+//	  cidr_blocks = [ "192.168.0.1/32" ]
+//	}
 //
-// ingress {
-//   from_port = 80
-//   to_port = 80
-//   protocol = "tcp"
-//   cidr_blocks = [ "192.168.0.2/32" ]
-// }
+//	ingress {
+//	  from_port = 80
+//	  to_port = 80
+//	  protocol = "tcp"
+//	  cidr_blocks = [ "192.168.0.2/32" ]
+//	}
 //
 // Then the Difference operation is executed on the new set
 // to find which rules got modified, and the resulting set
@@ -1271,7 +1272,6 @@ func resourceAwsSecurityGroupCollapseRules(ruleset string, rules []interface{})
 // to convert the "diff" back to a more compact form for
 // execution. Such compact form helps reduce the number of
 // API calls.
-//
 func resourceAwsSecurityGroupExpandRules(rules *schema.Set) *schema.Set {
 	var keys_to_expand = []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids", "security_groups"}
 
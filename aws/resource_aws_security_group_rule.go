@@ -2,6 +2,7 @@ package aws
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"log"
 	"sort"
@@ -11,11 +12,13 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 )
 
 func resourceAwsSecurityGroupRule() *schema.Resource {
@@ -280,7 +283,7 @@ information and instructions for recovery. Error message: %s`, sg_id, awsErr.Mes
 func resourceAwsSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	sg_id := d.Get("security_group_id").(string)
-	sg, err := findResourceSecurityGroup(conn, sg_id)
+	sg, err := findResourceSecurityGroup(conn, sg_id, meta.(*AWSClient).requestCache.Cacheable())
 	if _, notFound := err.(securityGroupNotFound); notFound {
 		// The security group containing this rule no longer exists.
 		d.SetId("")
@@ -407,11 +410,11 @@ func resourceAwsSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func findResourceSecurityGroup(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
+func findResourceSecurityGroup(conn *ec2.EC2, id string, optFns ...request.Option) (*ec2.SecurityGroup, error) {
 	req := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: []*string{aws.String(id)},
 	}
-	resp, err := conn.DescribeSecurityGroups(req)
+	resp, err := conn.DescribeSecurityGroupsWithContext(aws.BackgroundContext(), req, optFns...)
 	if err, ok := err.(awserr.Error); ok && err.Code() == "InvalidGroup.NotFound" {
 		return nil, securityGroupNotFound{id, nil}
 	}
@@ -941,8 +944,54 @@ func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.Resourc
 	return nil
 }
 
+var securityGroupRuleImportID = importid.New("_",
+	importid.Field{
+		Name:      "SECURITYGROUPID",
+		Attribute: "security_group_id",
+		ValidateFunc: func(v string) error {
+			if !strings.HasPrefix(v, "sg-") {
+				return errors.New("invalid security group ID")
+			}
+			return nil
+		},
+	},
+	importid.Field{
+		Name: "TYPE",
+		ValidateFunc: func(v string) error {
+			if v != "ingress" && v != "egress" {
+				return errors.New("expecting 'ingress' or 'egress'")
+			}
+			return nil
+		},
+	},
+	importid.Field{
+		Name: "PROTOCOL",
+		ValidateFunc: func(v string) error {
+			if _, ok := sgProtocolIntegers()[v]; !ok {
+				if _, err := strconv.Atoi(v); err != nil {
+					return errors.New("protocol must be tcp/udp/icmp/all or a number")
+				}
+			}
+			return nil
+		},
+	},
+	importid.Field{Name: "FROMPORT", Attribute: "from_port", Type: importid.TypeInt},
+	importid.Field{Name: "TOPORT", Attribute: "to_port", Type: importid.TypeInt},
+	importid.Field{
+		Name:     "SOURCE",
+		Repeated: true,
+		ValidateFunc: func(v string) error {
+			// will be properly validated later
+			if v != "self" && !strings.Contains(v, "sg-") && !strings.Contains(v, "pl-") && !strings.Contains(v, ":") && !strings.Contains(v, ".") {
+				return errors.New("source must be cidr, ipv6cidr, prefix list, 'self', or a sg ID")
+			}
+			return nil
+		},
+	},
+)
+
 // validateSecurityGroupRuleImportString does minimal validation of import string without going to AWS
-func validateSecurityGroupRuleImportString(importStr string) ([]string, error) {
+func validateSecurityGroupRuleImportString(importStr string) (importid.Parts, error) {
 	// example: sg-09a093729ef9382a6_ingress_tcp_8000_8000_10.0.3.0/24
 	// example: sg-09a093729ef9382a6_ingress_92_0_65536_10.0.3.0/24_10.0.4.0/24
 	// example: sg-09a093729ef9382a6_egress_tcp_8000_8000_10.0.3.0/24
@@ -952,43 +1001,17 @@ func validateSecurityGroupRuleImportString(importStr string) ([]string, error) {
 
 	log.Printf("[DEBUG] Validating import string %s", importStr)
 
-	importParts := strings.Split(strings.ToLower(importStr), "_")
-	errStr := "unexpected format of import string (%q), expected SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE[_SOURCE]*: %s"
-	if len(importParts) < 6 {
-		return nil, fmt.Errorf(errStr, importStr, "too few parts")
-	}
-
-	sgID := importParts[0]
-	ruleType := importParts[1]
-	protocol := importParts[2]
-	fromPort := importParts[3]
-	toPort := importParts[4]
-	sources := importParts[5:]
-
-	if !strings.HasPrefix(sgID, "sg-") {
-		return nil, fmt.Errorf(errStr, importStr, "invalid security group ID")
-	}
-
-	if ruleType != "ingress" && ruleType != "egress" {
-		return nil, fmt.Errorf(errStr, importStr, "expecting 'ingress' or 'egress'")
-	}
-
-	if _, ok := sgProtocolIntegers()[protocol]; !ok {
-		if _, err := strconv.Atoi(protocol); err != nil {
-			return nil, fmt.Errorf(errStr, importStr, "protocol must be tcp/udp/icmp/all or a number")
-		}
-	}
-
-	if p1, err := strconv.Atoi(fromPort); err != nil {
-		return nil, fmt.Errorf(errStr, importStr, "invalid port")
-	} else if p2, err := strconv.Atoi(toPort); err != nil || p2 < p1 {
-		return nil, fmt.Errorf(errStr, importStr, "invalid port")
+	importParts, err := securityGroupRuleImportID.Parse(strings.ToLower(importStr))
+	if err != nil {
+		return nil, err
 	}
 
-	for _, source := range sources {
-		// will be properly validated later
-		if source != "self" && !strings.Contains(source, "sg-") && !strings.Contains(source, "pl-") && !strings.Contains(source, ":") && !strings.Contains(source, ".") {
-			return nil, fmt.Errorf(errStr, importStr, "source must be cidr, ipv6cidr, prefix list, 'self', or a sg ID")
+	if importParts.Int(4) < importParts.Int(3) {
+		return nil, &importid.Error{
+			ID:     importStr,
+			Format: securityGroupRuleImportID.String(),
+			Field:  "TOPORT",
+			Err:    errors.New("must not be less than FROMPORT"),
 		}
 	}
 
@@ -996,23 +1019,16 @@ func validateSecurityGroupRuleImportString(importStr string) ([]string, error) {
 	return importParts, nil
 }
 
-func populateSecurityGroupRuleFromImport(d *schema.ResourceData, importParts []string) error {
+func populateSecurityGroupRuleFromImport(d *schema.ResourceData, importParts importid.Parts) error {
 	log.Printf("[DEBUG] Populating resource data on import: %v", importParts)
 
-	sgID := importParts[0]
 	ruleType := importParts[1]
 	protocol := importParts[2]
-	fromPort, err := strconv.Atoi(importParts[3])
-	if err != nil {
-		return err
-	}
-	toPort, err := strconv.Atoi(importParts[4])
-	if err != nil {
+	sources := strings.Split(importParts[5], "_")
+
+	if err := securityGroupRuleImportID.Set(d, importParts); err != nil {
 		return err
 	}
-	sources := importParts[5:]
-
-	d.Set("security_group_id", sgID)
 
 	if ruleType == "ingress" {
 		d.Set("type", ruleType)
@@ -1021,8 +1037,6 @@ func populateSecurityGroupRuleFromImport(d *schema.ResourceData, importParts []s
 	}
 
 	d.Set("protocol", protocolForValue(protocol))
-	d.Set("from_port", fromPort)
-	d.Set("to_port", toPort)
 
 	d.Set("self", false)
 	var cidrs []string
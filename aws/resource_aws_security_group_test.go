@@ -24,7 +24,7 @@ import (
 
 // add sweeper to delete known test sgs
 func init() {
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	addTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
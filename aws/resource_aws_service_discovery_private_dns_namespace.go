@@ -2,16 +2,21 @@ package aws
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicediscovery/waiter"
 )
 
+var serviceDiscoveryPrivateDnsNamespaceImportID = importid.New(":",
+	importid.Field{Name: "NAMESPACE_ID"},
+	importid.Field{Name: "VPC_ID", Attribute: "vpc"},
+)
+
 func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsServiceDiscoveryPrivateDnsNamespaceCreate,
@@ -19,15 +24,9 @@ func resourceAwsServiceDiscoveryPrivateDnsNamespace() *schema.Resource {
 		Update: resourceAwsServiceDiscoveryPrivateDnsNamespaceUpdate,
 		Delete: resourceAwsServiceDiscoveryPrivateDnsNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAMESPACE_ID:VPC_ID", d.Id())
-				}
-				d.SetId(idParts[0])
-				d.Set("vpc", idParts[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: serviceDiscoveryPrivateDnsNamespaceImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[0]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
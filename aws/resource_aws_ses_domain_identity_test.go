@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	addTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return testSweepSesIdentities(region, ses.IdentityTypeDomain) },
 	})
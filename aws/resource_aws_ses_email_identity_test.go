@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	addTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return testSweepSesIdentities(region, ses.IdentityTypeEmailAddress) },
 	})
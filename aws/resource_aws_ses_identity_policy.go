@@ -42,6 +42,7 @@ func resourceAwsSesIdentityPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -129,7 +130,7 @@ func resourceAwsSesIdentityPolicyRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("identity", identity)
 	d.Set("name", policyName)
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(policy)))
 
 	return nil
 }
@@ -11,12 +11,13 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
+	addTestSweepers("aws_sns_topic", &resource.Sweeper{
 		Name: "aws_sns_topic",
 		F:    testSweepSnsTopics,
 		Dependencies: []string{
@@ -50,37 +51,29 @@ func testSweepSnsTopics(region string) error {
 	conn := client.(*AWSClient).snsconn
 	var sweeperErrs *multierror.Error
 
-	err = conn.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, isLast bool) bool {
-		if page == nil {
-			return !isLast
-		}
-
-		for _, topic := range page.Topics {
-			arn := aws.StringValue(topic.TopicArn)
-
-			log.Printf("[INFO] Deleting SNS Topic: %s", arn)
-			_, err := conn.DeleteTopic(&sns.DeleteTopicInput{
-				TopicArn: aws.String(arn),
-			})
-			if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SNS Topic (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
-
-		return !isLast
-	})
+	arns, err := listSnsTopicArns(conn)
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SNS Topics sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		return nil
 	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SNS Topics: %w", err))
+		return fmt.Errorf("error retrieving SNS Topics: %w", err)
+	}
+
+	for _, arn := range arns {
+		log.Printf("[INFO] Deleting SNS Topic: %s", arn)
+		_, err := conn.DeleteTopic(&sns.DeleteTopicInput{
+			TopicArn: aws.String(arn),
+		})
+		if isAWSErr(err, sns.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			sweeperErr := fmt.Errorf("error deleting SNS Topic (%s): %w", arn, err)
+			log.Printf("[ERROR] %s", sweeperErr)
+			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			continue
+		}
 	}
 
 	return sweeperErrs.ErrorOrNil()
@@ -778,3 +771,69 @@ resource "aws_sns_topic" "test" {
 }
 `, r, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func TestResourceAwsSnsTopic_fakeaws(t *testing.T) {
+	server, client := testFakeAwsClient(t)
+	r := resourceAwsSnsTopic()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"display_name": "Test",
+		"name":         "test",
+	})
+
+	if err := resourceAwsSnsTopicCreate(d, client); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+
+	if got, expected := d.Id(), "arn:aws:sns:us-west-2:123456789012:test"; got != expected {
+		t.Errorf("got ID %s, expected %s", got, expected)
+	}
+
+	if got := d.Get("display_name").(string); got != "Test" {
+		t.Errorf("got display_name %q, expected %q", got, "Test")
+	}
+
+	// Import
+	imported := r.Data(&terraform.InstanceState{ID: d.Id()})
+
+	if err := resourceAwsSnsTopicRead(imported, client); err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+
+	for _, k := range []string{"arn", "display_name", "name", "policy"} {
+		if got, expected := imported.Get(k), d.Get(k); got != expected {
+			t.Errorf("imported %s: got %v, expected %v", k, got, expected)
+		}
+	}
+
+	// Drift
+	server.Lock()
+	server.SNSTopics[d.Id()].Attributes["DisplayName"] = "Drifted"
+	server.SNSTopics[d.Id()].Tags["Name"] = "drifted"
+	server.Unlock()
+
+	if err := resourceAwsSnsTopicRead(d, client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if got := d.Get("display_name").(string); got != "Drifted" {
+		t.Errorf("got display_name %q after drift, expected %q", got, "Drifted")
+	}
+
+	if got := d.Get("tags.Name").(string); got != "drifted" {
+		t.Errorf("got tags.Name %q after drift, expected %q", got, "drifted")
+	}
+
+	// Deletion outside of Terraform
+	server.Lock()
+	delete(server.SNSTopics, d.Id())
+	server.Unlock()
+
+	if err := resourceAwsSnsTopicRead(d, client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed from state, got ID %s", d.Id())
+	}
+}
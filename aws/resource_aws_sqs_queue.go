@@ -93,6 +93,7 @@ func resourceAwsSqsQueue() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"redrive_policy": {
 				Type:         schema.TypeString,
@@ -388,7 +389,7 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if v, ok := queueAttributes[sqs.QueueAttributeNamePolicy]; ok {
-			d.Set("policy", v)
+			d.Set("policy", iamPolicyToSet(d.Get("policy").(string), v))
 		}
 
 		if v, ok := queueAttributes[sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds]; ok && v != "" {
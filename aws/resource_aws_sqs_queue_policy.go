@@ -38,6 +38,7 @@ func resourceAwsSqsQueuePolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 		},
 	}
@@ -136,7 +137,7 @@ func resourceAwsSqsQueuePolicyRead(d *schema.ResourceData, meta interface{}) err
 
 	policy, ok := out.Attributes[sqs.QueueAttributeNamePolicy]
 	if ok {
-		d.Set("policy", policy)
+		d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(policy)))
 	} else {
 		d.Set("policy", "")
 	}
@@ -4,22 +4,25 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	awspolicy "github.com/jen20/awspolicyequivalence"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_sqs_queue", &resource.Sweeper{
+	addTestListSweepers("aws_sqs_queue", &sweep.Sweeper{
 		Name: "aws_sqs_queue",
-		F:    testSweepSqsQueues,
+		List: testSweepSqsQueues,
 		Dependencies: []string{
 			"aws_autoscaling_group",
 			"aws_cloudwatch_event_rule",
@@ -32,44 +35,85 @@ func init() {
 	})
 }
 
-func testSweepSqsQueues(region string) error {
+func testSweepSqsQueues(region string) ([]*sweep.Resource, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*AWSClient).sqsconn
-	input := &sqs.ListQueuesInput{}
-	var sweeperErrs *multierror.Error
+	var resources []*sweep.Resource
 
-	output, err := conn.ListQueues(input)
+	urls, err := listSqsQueueUrls(conn)
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping SQS Queues sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+		return nil, nil
 	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SQS Queues: %w", err))
-		return sweeperErrs
+		return nil, fmt.Errorf("error retrieving SQS Queues: %w", err)
 	}
 
-	for _, queueUrl := range output.QueueUrls {
-		url := aws.StringValue(queueUrl)
+	for _, url := range urls {
+		url := url
+		r := &sweep.Resource{
+			ID: url,
+			Delete: func() error {
+				_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{
+					QueueUrl: aws.String(url),
+				})
 
-		log.Printf("[INFO] Deleting SQS Queue: %s", url)
-		_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{
-			QueueUrl: aws.String(url),
-		})
-		if isAWSErr(err, sqs.ErrCodeQueueDoesNotExist, "") {
-			continue
+				if isAWSErr(err, sqs.ErrCodeQueueDoesNotExist, "") {
+					return nil
+				}
+
+				return err
+			},
 		}
+
+		output, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+			AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameCreatedTimestamp}),
+			QueueUrl:       aws.String(url),
+		})
 		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting SQS Queue (%s): %w", url, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+			log.Printf("[WARN] Error reading SQS Queue (%s) attributes: %s", url, err)
+		} else if v, err := strconv.ParseInt(aws.StringValue(output.Attributes[sqs.QueueAttributeNameCreatedTimestamp]), 10, 64); err == nil {
+			r.CreatedAt = time.Unix(v, 0)
+		}
+
+		if tags, err := keyvaluetags.SqsListTags(conn, url); err != nil {
+			log.Printf("[WARN] Error listing tags for SQS Queue (%s): %s", url, err)
+		} else {
+			r.Tags = tags.IgnoreAws().Map()
 		}
+
+		resources = append(resources, r)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return resources, nil
+}
+
+func TestAccAWSSQSQueue_replay(t *testing.T) {
+	var providers []*schema.Provider
+	var queueAttributes map[string]*string
+
+	resourceName := "aws_sqs_queue.queue"
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(10))
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckReplay(t) },
+		ProviderFactories: testAccProviderFactoriesReplay(t, &providers, queueName),
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckAWSSQSQueueDestroyWithProvider(s, providers[0])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigWithDefaults(queueName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExistsWithProvider(resourceName, &queueAttributes, func() *schema.Provider { return providers[0] }),
+					testAccCheckAWSSQSQueueDefaultAttributes(&queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "name", queueName),
+				),
+			},
+		},
+	})
 }
 
 func TestAccAWSSQSQueue_basic(t *testing.T) {
@@ -453,7 +497,11 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	return testAccCheckAWSSQSQueueDestroyWithProvider(s, testAccProvider)
+}
+
+func testAccCheckAWSSQSQueueDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	conn := provider.Meta().(*AWSClient).sqsconn
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -510,6 +558,10 @@ func testAccCheckAWSSQSQueuePolicyAttribute(queueAttributes *map[string]*string,
 }
 
 func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[string]*string) resource.TestCheckFunc {
+	return testAccCheckAWSSQSQueueExistsWithProvider(resourceName, queueAttributes, func() *schema.Provider { return testAccProvider })
+}
+
+func testAccCheckAWSSQSQueueExistsWithProvider(resourceName string, queueAttributes *map[string]*string, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -520,7 +572,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[str
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := providerF().Meta().(*AWSClient).sqsconn
 
 		input := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
@@ -787,3 +839,75 @@ resource "aws_sqs_queue" "queue" {
 }
 `, r)
 }
+
+func TestResourceAwsSqsQueue_fakeaws(t *testing.T) {
+	server, client := testFakeAwsClient(t)
+	r := resourceAwsSqsQueue()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"delay_seconds": 10,
+		"name":          "test",
+		"tags": map[string]interface{}{
+			"Name": "test",
+		},
+	})
+
+	if err := resourceAwsSqsQueueCreate(d, client); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+
+	if got, expected := d.Get("arn").(string), "arn:aws:sqs:us-west-2:123456789012:test"; got != expected {
+		t.Errorf("got arn %s, expected %s", got, expected)
+	}
+
+	if got := d.Get("delay_seconds").(int); got != 10 {
+		t.Errorf("got delay_seconds %d, expected 10", got)
+	}
+
+	if got := d.Get("visibility_timeout_seconds").(int); got != 30 {
+		t.Errorf("got visibility_timeout_seconds %d, expected 30", got)
+	}
+
+	if got := d.Get("tags.Name").(string); got != "test" {
+		t.Errorf("got tags.Name %q, expected %q", got, "test")
+	}
+
+	// Import
+	imported := r.Data(&terraform.InstanceState{ID: d.Id()})
+
+	if err := resourceAwsSqsQueueRead(imported, client); err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+
+	for _, k := range []string{"arn", "delay_seconds", "name", "tags.%", "tags.Name"} {
+		if got, expected := imported.Get(k), d.Get(k); got != expected {
+			t.Errorf("imported %s: got %v, expected %v", k, got, expected)
+		}
+	}
+
+	// Drift
+	server.Lock()
+	server.SQSQueues[d.Id()].Attributes[sqs.QueueAttributeNameDelaySeconds] = "20"
+	server.Unlock()
+
+	if err := resourceAwsSqsQueueRead(d, client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if got := d.Get("delay_seconds").(int); got != 20 {
+		t.Errorf("got delay_seconds %d after drift, expected 20", got)
+	}
+
+	// Deletion outside of Terraform
+	server.Lock()
+	delete(server.SQSQueues, d.Id())
+	server.Unlock()
+
+	if err := resourceAwsSqsQueueRead(d, client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed from state, got ID %s", d.Id())
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var ssmMaintenanceWindowTargetImportID = importid.New("/",
+	importid.Field{Name: "WINDOW_ID", Attribute: "window_id"},
+	importid.Field{Name: "WINDOW_TARGET_ID"},
 )
 
 func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
@@ -19,15 +24,9 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 		Update: resourceAwsSsmMaintenanceWindowTargetUpdate,
 		Delete: resourceAwsSsmMaintenanceWindowTargetDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW_ID/WINDOW_TARGET_ID", d.Id())
-				}
-				d.Set("window_id", idParts[0])
-				d.SetId(idParts[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: ssmMaintenanceWindowTargetImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[1]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -29,7 +29,7 @@ func resourceAwsSsmParameter() *schema.Resource {
 		Update: resourceAwsSsmParameterPut,
 		Delete: resourceAwsSsmParameterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: regionalImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,7 +96,8 @@ func resourceAwsSsmParameter() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"region": regionSchema(),
+			"tags":   tagsSchema(),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -110,7 +111,11 @@ func resourceAwsSsmParameter() *schema.Resource {
 }
 
 func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	ssmconn := client.ssmconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	log.Printf("[DEBUG] Reading SSM Parameter: %s", d.Id())
@@ -121,7 +126,7 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var resp *ssm.GetParameterOutput
-	err := resource.Retry(ssmParameterCreationValidationTimeout, func() *resource.RetryError {
+	err = resource.Retry(ssmParameterCreationValidationTimeout, func() *resource.RetryError {
 		var err error
 		resp, err = ssmconn.GetParameter(input)
 
@@ -198,23 +203,28 @@ func resourceAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
+		Partition: client.partition,
+		Region:    client.region,
 		Service:   "ssm",
-		AccountID: meta.(*AWSClient).accountid,
+		AccountID: client.accountid,
 		Resource:  fmt.Sprintf("parameter/%s", strings.TrimPrefix(d.Id(), "/")),
 	}
 	d.Set("arn", arn.String())
+	d.Set("region", client.region)
 
 	return nil
 }
 
 func resourceAwsSsmParameterDelete(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	ssmconn := client.ssmconn
 
 	log.Printf("[INFO] Deleting SSM Parameter: %s", d.Id())
 
-	_, err := ssmconn.DeleteParameter(&ssm.DeleteParameterInput{
+	_, err = ssmconn.DeleteParameter(&ssm.DeleteParameterInput{
 		Name: aws.String(d.Get("name").(string)),
 	})
 	if err != nil {
@@ -225,7 +235,11 @@ func resourceAwsSsmParameterDelete(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSsmParameterPut(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	client, err := regionalClient(d, meta)
+	if err != nil {
+		return err
+	}
+	ssmconn := client.ssmconn
 
 	log.Printf("[INFO] Creating SSM Parameter: %s", d.Get("name").(string))
 
@@ -252,7 +266,7 @@ func resourceAwsSsmParameterPut(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Waiting for SSM Parameter %v to be updated", d.Get("name"))
-	_, err := ssmconn.PutParameter(paramInput)
+	_, err = ssmconn.PutParameter(paramInput)
 
 	if isAWSErr(err, "ValidationException", "Tier is not supported") {
 		paramInput.Tier = nil
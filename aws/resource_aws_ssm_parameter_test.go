@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -667,3 +668,77 @@ func TestAWSSSMParameterShouldUpdate(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestResourceAwsSsmParameter_fakeaws(t *testing.T) {
+	server, client := testFakeAwsClient(t)
+	r := resourceAwsSsmParameter()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":  "/test",
+		"type":  ssm.ParameterTypeString,
+		"value": "one",
+		"tags": map[string]interface{}{
+			"Name": "test",
+		},
+	})
+
+	if err := resourceAwsSsmParameterPut(d, client); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+
+	if got, expected := d.Get("arn").(string), "arn:aws:ssm:us-west-2:123456789012:parameter/test"; got != expected {
+		t.Errorf("got arn %s, expected %s", got, expected)
+	}
+
+	if got := d.Get("version").(int); got != 1 {
+		t.Errorf("got version %d, expected 1", got)
+	}
+
+	if got := d.Get("tier").(string); got != ssm.ParameterTierStandard {
+		t.Errorf("got tier %q, expected %q", got, ssm.ParameterTierStandard)
+	}
+
+	// Import
+	imported := r.Data(&terraform.InstanceState{ID: d.Id()})
+
+	if err := resourceAwsSsmParameterRead(imported, client); err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+
+	for _, k := range []string{"arn", "name", "tags.Name", "type", "value", "version"} {
+		if got, expected := imported.Get(k), d.Get(k); got != expected {
+			t.Errorf("imported %s: got %v, expected %v", k, got, expected)
+		}
+	}
+
+	// Drift
+	server.Lock()
+	server.SSMParameters[d.Id()].Value = "two"
+	server.SSMParameters[d.Id()].Version++
+	server.Unlock()
+
+	if err := resourceAwsSsmParameterRead(d, client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if got := d.Get("value").(string); got != "two" {
+		t.Errorf("got value %q after drift, expected %q", got, "two")
+	}
+
+	if got := d.Get("version").(int); got != 2 {
+		t.Errorf("got version %d after drift, expected 2", got)
+	}
+
+	// Deletion outside of Terraform
+	server.Lock()
+	delete(server.SSMParameters, d.Id())
+	server.Unlock()
+
+	if err := resourceAwsSsmParameterRead(d, client); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed from state, got ID %s", d.Id())
+	}
+}
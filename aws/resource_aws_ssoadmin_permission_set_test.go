@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
+	addTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
 		Name: "aws_ssoadmin_permission_set",
 		F:    testSweepSsoAdminPermissionSets,
 		Dependencies: []string{
@@ -20,7 +20,7 @@ import (
 
 // add sweeper to delete known test subnets
 func init() {
-	resource.AddTestSweepers("aws_subnet", &resource.Sweeper{
+	addTestSweepers("aws_subnet", &resource.Sweeper{
 		Name: "aws_subnet",
 		F:    testSweepSubnets,
 		Dependencies: []string{
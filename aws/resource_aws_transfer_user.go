@@ -68,6 +68,7 @@ func resourceAwsTransferUser() *schema.Resource {
 				Optional:         true,
 				ValidateFunc:     validateIAMPolicyJson,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+				StateFunc:        iamPolicyStateFunc,
 			},
 
 			"role": {
@@ -169,7 +170,7 @@ func resourceAwsTransferUserRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("arn", resp.User.Arn)
 	d.Set("home_directory", resp.User.HomeDirectory)
 	d.Set("home_directory_type", resp.User.HomeDirectoryType)
-	d.Set("policy", resp.User.Policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), aws.StringValue(resp.User.Policy)))
 	d.Set("role", resp.User.Role)
 
 	if err := d.Set("home_directory_mappings", flattenAwsTransferHomeDirectoryMappings(resp.User.HomeDirectoryMappings)); err != nil {
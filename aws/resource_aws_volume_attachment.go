@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
+)
+
+var volumeAttachmentImportID = importid.New(":",
+	importid.Field{Name: "DEVICE_NAME", Attribute: "device_name"},
+	importid.Field{Name: "VOLUME_ID", Attribute: "volume_id"},
+	importid.Field{Name: "INSTANCE_ID", Attribute: "instance_id"},
 )
 
 func resourceAwsVolumeAttachment() *schema.Resource {
@@ -22,20 +28,9 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 		Update: resourceAwsVolumeAttachmentUpdate,
 		Delete: resourceAwsVolumeAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), ":")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected DEVICE_NAME:VOLUME_ID:INSTANCE_ID", d.Id())
-				}
-				deviceName := idParts[0]
-				volumeID := idParts[1]
-				instanceID := idParts[2]
-				d.Set("device_name", deviceName)
-				d.Set("volume_id", volumeID)
-				d.Set("instance_id", instanceID)
-				d.SetId(volumeAttachmentID(deviceName, volumeID, instanceID))
-				return []*schema.ResourceData{d}, nil
-			},
+			State: volumeAttachmentImportID.StateFunc(func(parts importid.Parts) string {
+				return volumeAttachmentID(parts[0], parts[1], parts[2])
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
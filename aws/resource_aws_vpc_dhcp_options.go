@@ -7,11 +7,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -191,13 +191,15 @@ func resourceAwsVpcDhcpOptionsRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("dhcp-options/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2DHCPOptions{
+		Partition:     meta.(*AWSClient).partition,
+		Region:        meta.(*AWSClient).region,
+		AccountID:     meta.(*AWSClient).accountid,
+		DHCPOptionsID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 DHCP Options Set (%s) ARN: %w", d.Id(), err)
+	}
 
 	d.Set("arn", arn)
 
@@ -7,12 +7,12 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -77,10 +77,7 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        iamPolicyStateFunc,
 			},
 			"prefix_list_id": {
 				Type:     schema.TypeString,
@@ -222,13 +219,15 @@ func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 	vpce := vpceRaw.(*ec2.VpcEndpoint)
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("vpc-endpoint/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2VPCEndpoint{
+		Partition:     meta.(*AWSClient).partition,
+		Region:        meta.(*AWSClient).region,
+		AccountID:     meta.(*AWSClient).accountid,
+		VPCEndpointID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 VPC Endpoint (%s) ARN: %w", d.Id(), err)
+	}
 	d.Set("arn", arn)
 
 	serviceName := aws.StringValue(vpce.ServiceName)
@@ -271,7 +270,7 @@ func resourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return fmt.Errorf("policy contains an invalid JSON: %s", err)
 	}
-	d.Set("policy", policy)
+	d.Set("policy", iamPolicyToSet(d.Get("policy").(string), policy))
 	d.Set("private_dns_enabled", vpce.PrivateDnsEnabled)
 	err = d.Set("route_table_ids", flattenStringSet(vpce.RouteTableIds))
 	if err != nil {
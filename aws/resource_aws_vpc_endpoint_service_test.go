@@ -14,7 +14,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	addTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    testSweepEc2VpcEndpointServices,
 		Dependencies: []string{
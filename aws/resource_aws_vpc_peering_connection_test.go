@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	addTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    testSweepEc2VpcPeeringConnections,
 	})
@@ -19,7 +19,7 @@ import (
 
 // add sweeper to delete known test vpcs
 func init() {
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	addTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
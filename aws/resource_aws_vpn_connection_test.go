@@ -35,7 +35,7 @@ type TunnelOptions struct {
 }
 
 func init() {
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	addTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    testSweepEc2VpnConnections,
 	})
@@ -7,10 +7,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
@@ -133,13 +133,15 @@ func resourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ec2",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("vpn-gateway/%s", d.Id()),
-	}.String()
+	arn, err := (&arns.EC2VPNGateway{
+		Partition:    meta.(*AWSClient).partition,
+		Region:       meta.(*AWSClient).region,
+		AccountID:    meta.(*AWSClient).accountid,
+		VPNGatewayID: d.Id(),
+	}).Build()
+	if err != nil {
+		return fmt.Errorf("error building EC2 VPN Gateway (%s) ARN: %w", d.Id(), err)
+	}
 
 	d.Set("arn", arn)
 
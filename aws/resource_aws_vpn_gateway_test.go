@@ -16,7 +16,7 @@ import (
 
 // add sweeper to delete known test VPN Gateways
 func init() {
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	addTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    testSweepVPNGateways,
 		Dependencies: []string{
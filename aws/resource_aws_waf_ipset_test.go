@@ -19,7 +19,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
+	addTestSweepers("aws_waf_ipset", &resource.Sweeper{
 		Name: "aws_waf_ipset",
 		F:    testSweepWafIPSet,
 		Dependencies: []string{
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
+	addTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
 		Name: "aws_waf_rate_based_rule",
 		F:    testSweepWafRateBasedRules,
 		Dependencies: []string{
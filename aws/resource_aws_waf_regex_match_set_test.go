@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
+	addTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
 		Name: "aws_waf_regex_match_set",
 		F:    testSweepWafRegexMatchSet,
 		Dependencies: []string{
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
+	addTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
 		Name: "aws_waf_size_constraint_set",
 		F:    testSweepWafSizeConstraintSet,
 		Dependencies: []string{
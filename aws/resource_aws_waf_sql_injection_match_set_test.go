@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
+	addTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
 		Name: "aws_waf_sql_injection_match_set",
 		F:    testSweepWafSqlInjectionMatchSet,
 		Dependencies: []string{
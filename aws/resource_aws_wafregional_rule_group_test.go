@@ -15,7 +15,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    testSweepWafRegionalRuleGroups,
 	})
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	addTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    testSweepWafRegionalRules,
 		Dependencies: []string{
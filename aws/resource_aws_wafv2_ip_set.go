@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,9 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+var wafv2IPSetImportID = importid.New("/",
+	importid.Field{Name: "ID"},
+	importid.Field{Name: "NAME", Attribute: "name"},
+	importid.Field{Name: "SCOPE", Attribute: "scope"},
+)
+
 func resourceAwsWafv2IPSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsWafv2IPSetCreate,
@@ -21,19 +27,9 @@ func resourceAwsWafv2IPSet() *schema.Resource {
 		Update: resourceAwsWafv2IPSetUpdate,
 		Delete: resourceAwsWafv2IPSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected ID/NAME/SCOPE", d.Id())
-				}
-				id := idParts[0]
-				name := idParts[1]
-				scope := idParts[2]
-				d.SetId(id)
-				d.Set("name", name)
-				d.Set("scope", scope)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: wafv2IPSetImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[0]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
+	addTestSweepers("aws_wafv2_rule_group", &resource.Sweeper{
 		Name: "aws_wafv2_rule_group",
 		F:    testSweepWafv2RuleGroups,
 		Dependencies: []string{
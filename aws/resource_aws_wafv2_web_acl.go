@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/importid"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -22,6 +22,12 @@ const (
 	Wafv2WebACLDeleteTimeout = 5 * time.Minute
 )
 
+var wafv2WebACLImportID = importid.New("/",
+	importid.Field{Name: "ID"},
+	importid.Field{Name: "NAME", Attribute: "name"},
+	importid.Field{Name: "SCOPE", Attribute: "scope"},
+)
+
 func resourceAwsWafv2WebACL() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsWafv2WebACLCreate,
@@ -29,19 +35,9 @@ func resourceAwsWafv2WebACL() *schema.Resource {
 		Update: resourceAwsWafv2WebACLUpdate,
 		Delete: resourceAwsWafv2WebACLDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idParts := strings.Split(d.Id(), "/")
-				if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-					return nil, fmt.Errorf("Unexpected format of ID (%q), expected ID/NAME/SCOPE", d.Id())
-				}
-				id := idParts[0]
-				name := idParts[1]
-				scope := idParts[2]
-				d.SetId(id)
-				d.Set("name", name)
-				d.Set("scope", scope)
-				return []*schema.ResourceData{d}, nil
-			},
+			State: wafv2WebACLImportID.StateFunc(func(parts importid.Parts) string {
+				return parts[0]
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
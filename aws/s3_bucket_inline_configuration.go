@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// s3BucketInlineConfigurationArguments maps each standalone S3 bucket
+// configuration resource to the aws_s3_bucket arguments that manage the same
+// configuration inline.
+var s3BucketInlineConfigurationArguments = map[string][]string{
+	"aws_s3_bucket_acl":                                  {"acl", "grant"},
+	"aws_s3_bucket_cors_configuration":                   {"cors_rule"},
+	"aws_s3_bucket_lifecycle_configuration":              {"lifecycle_rule"},
+	"aws_s3_bucket_logging":                              {"logging"},
+	"aws_s3_bucket_replication_configuration":            {"replication_configuration"},
+	"aws_s3_bucket_server_side_encryption_configuration": {"server_side_encryption_configuration"},
+	"aws_s3_bucket_versioning":                           {"versioning"},
+	"aws_s3_bucket_website_configuration":                {"website"},
+}
+
+// s3BucketInlineConfigurationChanges records the aws_s3_bucket arguments with
+// planned changes, keyed by bucket name and argument.
+//
+// Standalone resources reference their bucket, so the bucket is always planned
+// first and they can refuse to plan while the same configuration is also being
+// changed inline. This is best effort: a conflict is only detected once the
+// inline argument has a planned change, which is at the latest the plan after
+// the standalone resource has overwritten the inline configuration.
+var s3BucketInlineConfigurationChanges = &s3BucketInlineConfigurationChangeSet{
+	changes: make(map[string]bool),
+}
+
+type s3BucketInlineConfigurationChangeSet struct {
+	sync.Mutex
+	changes map[string]bool
+}
+
+func (c *s3BucketInlineConfigurationChangeSet) set(bucket, argument string, changed bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	if changed {
+		c.changes[bucket+"/"+argument] = true
+	} else {
+		delete(c.changes, bucket+"/"+argument)
+	}
+}
+
+func (c *s3BucketInlineConfigurationChangeSet) changed(bucket, argument string) bool {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.changes[bucket+"/"+argument]
+}
+
+// resourceAwsS3BucketCustomizeDiff records which inline configuration
+// arguments have planned changes.
+func resourceAwsS3BucketCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("bucket") {
+		return nil
+	}
+
+	bucket := diff.Get("bucket").(string)
+
+	if bucket == "" {
+		return nil
+	}
+
+	for _, arguments := range s3BucketInlineConfigurationArguments {
+		for _, argument := range arguments {
+			changed := diff.HasChange(argument) && diff.NewValueKnown(argument)
+
+			// New buckets are always created with the default canned ACL.
+			if argument == "acl" && diff.Id() == "" && diff.Get(argument).(string) == "private" {
+				changed = false
+			}
+
+			s3BucketInlineConfigurationChanges.set(bucket, argument, changed)
+		}
+	}
+
+	return nil
+}
+
+// s3BucketInlineConfigurationConflictCustomizeDiff returns a CustomizeDiffFunc
+// for the standalone resource type that errors if its bucket's configuration
+// is also being changed by an inline aws_s3_bucket argument.
+func s3BucketInlineConfigurationConflictCustomizeDiff(resourceType string) schema.CustomizeDiffFunc {
+	arguments, ok := s3BucketInlineConfigurationArguments[resourceType]
+
+	if !ok {
+		panic(fmt.Sprintf("no inline aws_s3_bucket arguments for %s", resourceType))
+	}
+
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("bucket") {
+			return nil
+		}
+
+		bucket := diff.Get("bucket").(string)
+
+		var conflicts []string
+
+		for _, argument := range arguments {
+			if s3BucketInlineConfigurationChanges.changed(bucket, argument) {
+				conflicts = append(conflicts, argument)
+			}
+		}
+
+		if len(conflicts) > 0 {
+			return fmt.Errorf("S3 Bucket (%s) configuration is managed by both %s and the aws_s3_bucket %s argument, remove one of them", bucket, resourceType, strings.Join(conflicts, ", "))
+		}
+
+		return nil
+	}
+}
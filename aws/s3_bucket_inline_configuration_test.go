@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestS3BucketInlineConfigurationArguments(t *testing.T) {
+	provider := Provider()
+	bucketSchema := resourceAwsS3Bucket().Schema
+
+	for resourceType, arguments := range s3BucketInlineConfigurationArguments {
+		if _, ok := provider.ResourcesMap[resourceType]; !ok {
+			t.Errorf("resource %s is not registered", resourceType)
+		}
+
+		for _, argument := range arguments {
+			if _, ok := bucketSchema[argument]; !ok {
+				t.Errorf("resource %s: aws_s3_bucket has no %s argument", resourceType, argument)
+			}
+		}
+	}
+}
+
+func TestS3BucketInlineConfigurationChangeSet(t *testing.T) {
+	changes := &s3BucketInlineConfigurationChangeSet{
+		changes: make(map[string]bool),
+	}
+
+	changes.set("test", "lifecycle_rule", true)
+
+	if !changes.changed("test", "lifecycle_rule") {
+		t.Error("expected test lifecycle_rule to be changed")
+	}
+
+	if changes.changed("test", "cors_rule") {
+		t.Error("expected test cors_rule not to be changed")
+	}
+
+	if changes.changed("other", "lifecycle_rule") {
+		t.Error("expected other lifecycle_rule not to be changed")
+	}
+
+	changes.set("test", "lifecycle_rule", false)
+
+	if changes.changed("test", "lifecycle_rule") {
+		t.Error("expected test lifecycle_rule not to be changed after reset")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/arns"
 )
 
 const (
@@ -711,6 +712,14 @@ func validateArn(v interface{}, k string) (ws []string, errors []error) {
 		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing resource value", k, value))
 	}
 
+	if len(errors) > 0 {
+		return ws, errors
+	}
+
+	if err := arns.Validate(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %w", k, value, err))
+	}
+
 	return ws, errors
 }
 
@@ -1008,8 +1017,9 @@ func validateIAMPolicyJson(v interface{}, k string) (ws []string, errors []error
 	}
 	if _, err := structure.NormalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
 	}
-	return
+	return lintIAMPolicyJson(value, k)
 }
 
 func validateStringIsJsonOrYaml(v interface{}, k string) (ws []string, errors []error) {
@@ -2506,6 +2516,19 @@ var validateServiceDiscoveryNamespaceName = validation.All(
 	validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z._-]+$`), ""),
 )
 
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
 // validateNestedExactlyOneOf is called on the map representing a nested schema element
 // Once ExactlyOneOf is supported for nested elements, this should be deprecated.
 func validateNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
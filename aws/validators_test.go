@@ -350,6 +350,8 @@ func TestValidateArn(t *testing.T) {
 		"arn:aws",
 		"arn:aws:logs",            //lintignore:AWSAT005
 		"arn:aws:logs:region:*:*", //lintignore:AWSAT005
+		"arn:aws:ec2:us-east-1:123456789012:instance/vol-12345678", //lintignore:AWSAT003,AWSAT005 // EC2 instance with volume ID
+		"arn:aws:iam:us-east-1:123456789012:role/example",          //lintignore:AWSAT003,AWSAT005 // Regional IAM Role
 	}
 	for _, v := range invalidNames {
 		_, errors := validateArn(v, "arn")
@@ -3308,3 +3310,33 @@ func TestValidateUTCTimestamp(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDuration(t *testing.T) {
+	validDurations := []string{
+		"0s",
+		"500ms",
+		"5s",
+		"1m30s",
+	}
+
+	invalidDurations := []string{
+		"",
+		"5",
+		"five seconds",
+		"-5s",
+	}
+
+	for _, v := range validDurations {
+		_, errors := validateDuration(v, "backoff")
+		if len(errors) > 0 {
+			t.Fatalf("expected the duration %q to be valid, got error %q", v, errors)
+		}
+	}
+
+	for _, v := range invalidDurations {
+		_, errors := validateDuration(v, "backoff")
+		if len(errors) == 0 {
+			t.Fatalf("expected the duration %q to fail validation", v)
+		}
+	}
+}
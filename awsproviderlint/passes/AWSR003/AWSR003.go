@@ -0,0 +1,177 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of nested block attributes without error checking
+
+The AWSR003 analyzer reports when the error returned by a
+(schema.ResourceData).Set() call is not checked and the value is a nested
+block, i.e. contains map[string]interface{} values. Values built by a
+function in the same package, e.g. flattenXxx(), are inspected as well.
+Setting nested blocks fails when the value does not match the schema,
+which would otherwise silently leave the attribute unset in the state.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	setCallExprs := make(map[*ast.CallExpr]bool, len(callExprs))
+
+	for _, callExpr := range callExprs {
+		setCallExprs[callExpr] = true
+	}
+
+	funcDecls := make(map[types.Object]*ast.FuncDecl)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+				funcDecls[pass.TypesInfo.Defs[funcDecl.Name]] = funcDecl
+			}
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 && isBlankIdent(n.Lhs[0]) {
+				callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+			}
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil || !setCallExprs[callExpr] || len(callExpr.Args) < 2 {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, n) {
+			return
+		}
+
+		if !isNestedBlockValue(pass.TypesInfo, funcDecls, callExpr.Args[1]) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: missing error check of d.Set() with nested block value", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// isNestedBlockValue returns whether the expression, or the declaration of the
+// same package function it calls, contains map[string]interface{} values.
+func isNestedBlockValue(info *types.Info, funcDecls map[types.Object]*ast.FuncDecl, e ast.Expr) bool {
+	if containsMapStringInterface(info, e) {
+		return true
+	}
+
+	callExpr, ok := e.(*ast.CallExpr)
+
+	if !ok {
+		return false
+	}
+
+	var ident *ast.Ident
+
+	switch fun := callExpr.Fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return false
+	}
+
+	funcDecl, ok := funcDecls[info.Uses[ident]]
+
+	if !ok || funcDecl.Body == nil {
+		return false
+	}
+
+	return containsMapStringInterface(info, funcDecl.Body)
+}
+
+func containsMapStringInterface(info *types.Info, node ast.Node) bool {
+	var found bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		e, ok := n.(ast.Expr)
+
+		if !ok {
+			return true
+		}
+
+		if isMapStringInterface(info.TypeOf(e)) {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func isMapStringInterface(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	m, ok := t.Underlying().(*types.Map)
+
+	if !ok {
+		return false
+	}
+
+	key, ok := m.Key().Underlying().(*types.Basic)
+
+	if !ok || key.Kind() != types.String {
+		return false
+	}
+
+	elem, ok := m.Elem().Underlying().(*types.Interface)
+
+	return ok && elem.Empty()
+}
+
+func isBlankIdent(e ast.Expr) bool {
+	ident, ok := e.(*ast.Ident)
+
+	return ok && ident.Name == "_"
+}
@@ -0,0 +1,68 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f(d *schema.ResourceData) error {
+	/* Passing cases */
+
+	d.Set("name", "example")
+
+	d.Set("tags", map[string]string{"Name": "example"})
+
+	d.Set("names", flattenStringList([]string{"example"}))
+
+	if err := d.Set("block", flattenBlock("example")); err != nil {
+		return fmt.Errorf("error setting block: %w", err)
+	}
+
+	if err := d.Set("block", []interface{}{map[string]interface{}{"name": "example"}}); err != nil {
+		return fmt.Errorf("error setting block: %w", err)
+	}
+
+	err := d.Set("block", flattenBlock("example"))
+
+	if err != nil {
+		return fmt.Errorf("error setting block: %w", err)
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	d.Set("block", flattenBlock("example"))
+
+	d.Set("block", flattenBlock("example")) //lintignore:AWSR003
+
+	/* Failing cases */
+
+	d.Set("block", flattenBlock("example")) // want "missing error check of d.Set\\(\\) with nested block value"
+
+	_ = d.Set("block", flattenBlock("example")) // want "missing error check of d.Set\\(\\) with nested block value"
+
+	d.Set("block", []interface{}{map[string]interface{}{"name": "example"}}) // want "missing error check of d.Set\\(\\) with nested block value"
+
+	d.Set("block", []map[string]interface{}{{"name": "example"}}) // want "missing error check of d.Set\\(\\) with nested block value"
+
+	return nil
+}
+
+func flattenBlock(name string) []interface{} {
+	m := map[string]interface{}{
+		"name": name,
+	}
+
+	return []interface{}{m}
+}
+
+func flattenStringList(list []string) []interface{} {
+	vs := make([]interface{}, 0, len(list))
+
+	for _, v := range list {
+		vs = append(vs, v)
+	}
+
+	return vs
+}
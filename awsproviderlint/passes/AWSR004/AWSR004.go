@@ -0,0 +1,203 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for d.SetId("") in Read functions without [WARN] log and !d.IsNewResource() guard
+
+The AWSR004 analyzer reports when a d.SetId("") call, which removes the
+resource from the Terraform state, in a Read function (a schema.ReadFunc
+declaration named with the Read suffix) is not preceded by a log.Printf()
+call with a [WARN] message, or is not guarded by !d.IsNewResource().
+
+Without the log message, resources silently disappear from state. Without
+the guard, eventually consistent APIs that cannot yet find a newly created
+resource cause an error about the resource being missing after creation
+instead of an error pointing to the real problem.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		if crudFunc.AstFuncDecl == nil || !strings.HasSuffix(crudFunc.AstFuncDecl.Name.Name, "Read") {
+			continue
+		}
+
+		var stack []ast.Node
+
+		ast.Inspect(crudFunc.Body, func(n ast.Node) bool {
+			if n == nil {
+				stack = stack[:len(stack)-1]
+				return true
+			}
+
+			stack = append(stack, n)
+
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || !isResourceDataMethod(callExpr, pass.TypesInfo, "SetId") || len(callExpr.Args) != 1 {
+				return true
+			}
+
+			if id := astutils.ExprStringValue(callExpr.Args[0]); id == nil || *id != "" {
+				return true
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				return true
+			}
+
+			preceding := precedingStmts(stack)
+
+			if !hasWarnLog(pass.TypesInfo, preceding) {
+				pass.Reportf(callExpr.Pos(), "%s: missing log.Printf(\"[WARN] ...\") before d.SetId(\"\")", analyzerName)
+			}
+
+			if !hasIsNewResourceGuard(pass.TypesInfo, stack, preceding) {
+				pass.Reportf(callExpr.Pos(), "%s: missing !d.IsNewResource() guard for d.SetId(\"\")", analyzerName)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// precedingStmts returns the statements before the statement containing the
+// last node of the stack in its innermost enclosing statement list.
+func precedingStmts(stack []ast.Node) []ast.Stmt {
+	for i := len(stack) - 2; i >= 0; i-- {
+		var list []ast.Stmt
+
+		switch n := stack[i].(type) {
+		case *ast.BlockStmt:
+			list = n.List
+		case *ast.CaseClause:
+			list = n.Body
+		case *ast.CommClause:
+			list = n.Body
+		default:
+			continue
+		}
+
+		for j, stmt := range list {
+			if stmt == stack[i+1] {
+				return list[:j]
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasWarnLog(info *types.Info, stmts []ast.Stmt) bool {
+	for _, stmt := range stmts {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+
+		if !ok {
+			continue
+		}
+
+		callExpr, ok := exprStmt.X.(*ast.CallExpr)
+
+		if !ok || !astutils.IsStdlibPackageFunc(callExpr.Fun, info, "log", "Printf") || len(callExpr.Args) == 0 {
+			continue
+		}
+
+		if format := astutils.ExprStringValue(callExpr.Args[0]); format != nil && strings.HasPrefix(*format, "[WARN]") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasIsNewResourceGuard returns whether an enclosing if statement or switch case
+// condition contains !d.IsNewResource() or a preceding if statement condition checks
+// d.IsNewResource(), e.g. to return an error.
+func hasIsNewResourceGuard(info *types.Info, stack []ast.Node, preceding []ast.Stmt) bool {
+	for i, n := range stack[:len(stack)-1] {
+		switch n := n.(type) {
+		case *ast.CaseClause:
+			for _, e := range n.List {
+				if containsIsNewResource(info, e, true) {
+					return true
+				}
+			}
+		case *ast.IfStmt:
+			if stack[i+1] == n.Body && containsIsNewResource(info, n.Cond, true) {
+				return true
+			}
+		}
+	}
+
+	for _, stmt := range preceding {
+		if ifStmt, ok := stmt.(*ast.IfStmt); ok && containsIsNewResource(info, ifStmt.Cond, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsIsNewResource(info *types.Info, e ast.Expr, negated bool) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		if negated {
+			unaryExpr, ok := n.(*ast.UnaryExpr)
+
+			if !ok || unaryExpr.Op != token.NOT {
+				return true
+			}
+
+			n = unaryExpr.X
+
+			for parenExpr, ok := n.(*ast.ParenExpr); ok; parenExpr, ok = n.(*ast.ParenExpr) {
+				n = parenExpr.X
+			}
+		}
+
+		if callExpr, ok := n.(*ast.CallExpr); ok && isResourceDataMethod(callExpr, info, "IsNewResource") {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
+
+func isResourceDataMethod(callExpr *ast.CallExpr, info *types.Info, methodName string) bool {
+	return schema.IsReceiverMethod(callExpr.Fun, info, schema.TypeNameResourceData, methodName)
+}
@@ -0,0 +1,130 @@
+package a
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var errNotFound = errors.New("not found")
+
+func find(id string) error {
+	return errNotFound
+}
+
+/* Passing cases */
+
+func resourceExamplePassingGuardRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if !d.IsNewResource() && errors.Is(err, errNotFound) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourceExamplePassingNewResourceErrorRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if errors.Is(err, errNotFound) {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Example (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourceExamplePassingSwitchRead(d *schema.ResourceData, meta interface{}) error {
+	switch err := find(d.Id()); {
+	case !d.IsNewResource() && errors.Is(err, errNotFound):
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	return nil
+}
+
+func resourceExamplePassingDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
+
+func resourceExamplePassingSetIdRead(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("example")
+
+	return nil
+}
+
+/* Comment ignored cases */
+
+func resourceExampleIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	if errors.Is(find(d.Id()), errNotFound) {
+		//lintignore:AWSR004
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceExampleFailingNoGuardRead(d *schema.ResourceData, meta interface{}) error {
+	if errors.Is(find(d.Id()), errNotFound) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("") // want "missing !d.IsNewResource\\(\\) guard for d.SetId"
+		return nil
+	}
+
+	return nil
+}
+
+func resourceExampleFailingNoLogRead(d *schema.ResourceData, meta interface{}) error {
+	if !d.IsNewResource() && errors.Is(find(d.Id()), errNotFound) {
+		d.SetId("") // want "missing log.Printf\\(\"\\[WARN\\] ...\"\\) before d.SetId"
+		return nil
+	}
+
+	return nil
+}
+
+func resourceExampleFailingDebugLogRead(d *schema.ResourceData, meta interface{}) error {
+	if !d.IsNewResource() && errors.Is(find(d.Id()), errNotFound) {
+		log.Printf("[DEBUG] Example (%s) not found, removing from state", d.Id())
+		d.SetId("") // want "missing log.Printf\\(\"\\[WARN\\] ...\"\\) before d.SetId"
+		return nil
+	}
+
+	return nil
+}
+
+func resourceExampleFailingElseGuardRead(d *schema.ResourceData, meta interface{}) error {
+	if !d.IsNewResource() {
+		return nil
+	} else {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("") // want "missing !d.IsNewResource\\(\\) guard for d.SetId"
+	}
+
+	return nil
+}
+
+func resourceExampleFailingBothRead(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("") // want "missing log.Printf\\(\"\\[WARN\\] ...\"\\) before d.SetId" "missing !d.IsNewResource\\(\\) guard for d.SetId"
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package AWSR005
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for finder functions returning nil, nil
+
+The AWSR005 analyzer reports when a function in a finder package returns
+nil for all results, including the error, e.g. when the API output is empty.
+Callers then need to check both the error and the result, and forgetting
+the result check causes nil pointer dereferences. Return a
+*resource.NotFoundError instead, which callers can check with
+tfresource.NotFound().
+`
+
+const analyzerName = "AWSR005"
+
+const finderPackageName = "finder"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Name() != finderPackageName {
+		return nil, nil
+	}
+
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		funcDecl := n.(*ast.FuncDecl)
+
+		if funcDecl.Body == nil || !funcDecl.Name.IsExported() {
+			return
+		}
+
+		results := funcDecl.Type.Results
+
+		if results == nil || results.NumFields() < 2 || !astutils.IsFieldListType(results, len(results.List)-1, astutils.IsExprTypeError) {
+			return
+		}
+
+		ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ReturnStmt:
+				if !isAllNil(n.Results) || commentIgnorer.ShouldIgnore(analyzerName, n) {
+					return false
+				}
+
+				pass.Reportf(n.Pos(), "%s: prefer returning &resource.NotFoundError{} instead of nil, nil", analyzerName)
+
+				return false
+			}
+
+			return true
+		})
+	})
+
+	return nil, nil
+}
+
+func isAllNil(exprs []ast.Expr) bool {
+	if len(exprs) < 2 {
+		return false
+	}
+
+	for _, e := range exprs {
+		if ident, ok := e.(*ast.Ident); !ok || ident.Name != "nil" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,62 @@
+package finder
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+type Example struct{}
+
+/* Passing cases */
+
+func ExampleByID(id string) (*Example, error) {
+	if id == "" {
+		return nil, &resource.NotFoundError{
+			Message: "empty result",
+		}
+	}
+
+	if id == "error" {
+		return nil, errors.New("error")
+	}
+
+	return &Example{}, nil
+}
+
+func ExampleNames(id string) []string {
+	return nil
+}
+
+func ExampleByIDWithRetry(id string) (*Example, error) {
+	retry := func() (*Example, error) {
+		return nil, nil
+	}
+
+	return retry()
+}
+
+func exampleByName(name string) (*Example, error) {
+	return nil, nil
+}
+
+/* Comment ignored cases */
+
+func ExampleByName(name string) (*Example, error) {
+	//lintignore:AWSR005
+	return nil, nil
+}
+
+/* Failing cases */
+
+func ExampleByARN(arn string) (*Example, error) {
+	if arn == "" {
+		return nil, nil // want "prefer returning &resource.NotFoundError{} instead of nil, nil"
+	}
+
+	return &Example{}, nil
+}
+
+func ExampleStatus(id string) (*Example, []string, error) {
+	return nil, nil, nil // want "prefer returning &resource.NotFoundError{} instead of nil, nil"
+}
@@ -0,0 +1,13 @@
+package a
+
+type example struct{}
+
+/* Passing cases */
+
+func findExample(id string) (*example, error) {
+	if id == "" {
+		return nil, nil
+	}
+
+	return &example{}, nil
+}
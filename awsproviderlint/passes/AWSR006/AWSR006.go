@@ -0,0 +1,87 @@
+package AWSR006
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Create functions not ending by calling the Read function
+
+The AWSR006 analyzer reports when a Create function (a schema.CreateFunc
+declaration named with the Create suffix) does not end by returning the
+result of a Read function call, e.g. return resourceAwsExampleRead(d, meta).
+Without it, computed attributes are not set in the Terraform state after
+creation. Returning the result of an Update function call, which in turn
+calls the Read function, is also accepted.
+`
+
+const analyzerName = "AWSR006"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		funcDecl := crudFunc.AstFuncDecl
+
+		if funcDecl == nil || !strings.HasSuffix(funcDecl.Name.Name, "Create") {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, funcDecl) {
+			continue
+		}
+
+		if endsWithReadCall(crudFunc.Body) {
+			continue
+		}
+
+		pass.Reportf(funcDecl.Name.Pos(), "%s: Create function should end by returning the Read function result", analyzerName)
+	}
+
+	return nil, nil
+}
+
+func endsWithReadCall(body *ast.BlockStmt) bool {
+	if body == nil || len(body.List) == 0 {
+		return false
+	}
+
+	returnStmt, ok := body.List[len(body.List)-1].(*ast.ReturnStmt)
+
+	if !ok || len(returnStmt.Results) != 1 {
+		return false
+	}
+
+	callExpr, ok := returnStmt.Results[0].(*ast.CallExpr)
+
+	if !ok {
+		return false
+	}
+
+	var name string
+
+	switch fun := callExpr.Fun.(type) {
+	case *ast.Ident:
+		name = fun.Name
+	case *ast.SelectorExpr:
+		name = fun.Sel.Name
+	}
+
+	return strings.Contains(name, "Read") || strings.HasSuffix(name, "Update")
+}
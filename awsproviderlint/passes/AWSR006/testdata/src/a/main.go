@@ -0,0 +1,58 @@
+package a
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceExampleRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceExampleUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceExampleRead(d, meta)
+}
+
+/* Passing cases */
+
+func resourceExamplePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("example")
+
+	return resourceExampleRead(d, meta)
+}
+
+func resourceExamplePassingUpdateCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("example")
+
+	return resourceExampleUpdate(d, meta)
+}
+
+func resourceExamplePassingDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+/* Comment ignored cases */
+
+// lintignore:AWSR006
+func resourceExampleIgnoredCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("example")
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceExampleFailingCreate(d *schema.ResourceData, meta interface{}) error { // want "Create function should end by returning the Read function result"
+	d.SetId("example")
+
+	return nil
+}
+
+func resourceExampleFailingErrorCreate(d *schema.ResourceData, meta interface{}) error { // want "Create function should end by returning the Read function result"
+	if err := resourceExampleRead(d, meta); err != nil {
+		return err
+	}
+
+	return errors.New("example")
+}
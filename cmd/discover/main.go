@@ -0,0 +1,135 @@
+// Command discover lists existing resources of the given types in a region and writes
+// Terraform configuration populated from each resource's current state, along with
+// the terraform import commands for bringing the resources under management.
+//
+// Usage:
+//
+//	go run ./cmd/discover -region us-west-2 -types aws_iam_role,aws_sqs_queue -out ./imported
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws"
+)
+
+func main() {
+	var (
+		region            string
+		profile           string
+		types             string
+		ignoreTagKeys     string
+		ignoreTagPrefixes string
+		outDir            string
+		listTypes         bool
+	)
+
+	flag.StringVar(&region, "region", "", "AWS region, defaults to the AWS_REGION or AWS_DEFAULT_REGION environment variable")
+	flag.StringVar(&profile, "profile", "", "AWS shared credentials profile")
+	flag.StringVar(&types, "types", "", "comma-separated list of resource types to discover, defaults to all supported types")
+	flag.StringVar(&ignoreTagKeys, "ignore-tag-keys", "", "comma-separated list of resource tag keys to ignore, as the provider ignore_tags keys argument")
+	flag.StringVar(&ignoreTagPrefixes, "ignore-tag-prefixes", "", "comma-separated list of resource tag key prefixes to ignore, as the provider ignore_tags key_prefixes argument")
+	flag.StringVar(&outDir, "out", ".", "directory to write the discovered.tf and import.sh files to")
+	flag.BoolVar(&listTypes, "list-types", false, "print the supported resource types and exit")
+	flag.Parse()
+
+	if listTypes {
+		fmt.Println(strings.Join(aws.DiscoverableResourceTypes(), "\n"))
+		return
+	}
+
+	resourceTypes := aws.DiscoverableResourceTypes()
+
+	if types != "" {
+		resourceTypes = splitList(types)
+	}
+
+	ctx := context.Background()
+	p := aws.Provider()
+
+	config := map[string]interface{}{
+		"profile": profile,
+	}
+
+	if region != "" {
+		config["region"] = region
+	}
+
+	if ignoreTagKeys != "" || ignoreTagPrefixes != "" {
+		config["ignore_tags"] = []interface{}{
+			map[string]interface{}{
+				"keys":         toInterfaceSlice(splitList(ignoreTagKeys)),
+				"key_prefixes": toInterfaceSlice(splitList(ignoreTagPrefixes)),
+			},
+		}
+	}
+
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		log.Fatalf("error configuring provider: %v", diags)
+	}
+
+	var configs, commands strings.Builder
+
+	commands.WriteString("#!/bin/sh\nset -e\n\n")
+
+	for _, resourceType := range resourceTypes {
+		resources, err := aws.DiscoverResources(ctx, p, resourceType)
+
+		if err != nil {
+			log.Fatalf("error discovering %s resources: %s", resourceType, err)
+		}
+
+		log.Printf("discovered %d %s resources", len(resources), resourceType)
+
+		for _, resource := range resources {
+			if configs.Len() > 0 {
+				configs.WriteString("\n")
+			}
+
+			configs.WriteString(resource.Config)
+			commands.WriteString(resource.ImportCommand)
+		}
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		log.Fatalf("error creating output directory: %s", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(outDir, "discovered.tf"), []byte(configs.String()), 0644); err != nil {
+		log.Fatalf("error writing configuration: %s", err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(outDir, "import.sh"), []byte(commands.String()), 0755); err != nil {
+		log.Fatalf("error writing import commands: %s", err)
+	}
+}
+
+func splitList(s string) []string {
+	var l []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
+
+func toInterfaceSlice(l []string) []interface{} {
+	vs := make([]interface{}, len(l))
+
+	for i, v := range l {
+		vs[i] = v
+	}
+
+	return vs
+}